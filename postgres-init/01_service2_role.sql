@@ -0,0 +1,8 @@
+-- Роль service2 для db_rls: не суперпользователь и без BYPASSRLS, чтобы на неё
+-- действовала политика hashes_tenant_isolation. Таблицы создаёт migrator
+-- под postgres, права на них выдаются заранее.
+CREATE ROLE service2 LOGIN PASSWORD 'service2' NOSUPERUSER NOBYPASSRLS;
+ALTER DEFAULT PRIVILEGES FOR ROLE postgres IN SCHEMA public
+    GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO service2;
+ALTER DEFAULT PRIVILEGES FOR ROLE postgres IN SCHEMA public
+    GRANT USAGE, SELECT ON SEQUENCES TO service2;
@@ -10,13 +10,21 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"service1/internal/config"
+	"service1/internal/discovery"
 	"service1/internal/server"
+	"service1/internal/tlsconf"
+	"service1/pkg/hasher"
 	"service1/proto/hasherpb"
 	"syscall"
+	"time"
 )
 
 func main() {
@@ -30,6 +38,14 @@ func main() {
 	log.Hooks.Add(hook)
 	log.SetFormatter(logFormatter)
 
+	appCfg, err := config.Load(ctx, "consul:8500")
+	if err != nil {
+		werr := errors.WithStack(err)
+		log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("consul init error")
+		return
+	}
+
 	listener, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		werr := errors.WithStack(err)
@@ -41,20 +57,94 @@ func main() {
 	grpcMetrics := server.NewServerMetrics()
 	prometheus.MustRegister(grpcMetrics)
 
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			server.UnaryRequestID(log),
-			server.LoggingInterceptor(log),
-			grpcMetrics.UnaryServerInterceptor(),
-		),
+	unary := []grpc.UnaryServerInterceptor{server.UnaryRequestID(log)}
+	stream := []grpc.StreamServerInterceptor{server.StreamRequestID(log)}
+	// аутентификация до логирования, чтобы имя клиента попало в его поля;
+	// отказы auth логирует сам
+	if len(appCfg.Clients) > 0 {
+		clients := make([]server.Client, 0, len(appCfg.Clients))
+		for name, c := range appCfg.Clients {
+			clients = append(clients, server.Client{Name: name, Token: c.Token, HMACSecret: c.HMACSecret, Methods: c.Methods, Priority: c.Priority})
+		}
+		auth := server.NewAuthenticator(clients, log)
+		unary = append(unary, server.UnaryAuth(auth))
+		stream = append(stream, server.StreamAuth(auth))
+	} else {
+		log.Warn("client authentication is disabled, anyone can call service1")
+	}
+	unary = append(unary, server.LoggingInterceptor(log), grpcMetrics.UnaryServerInterceptor())
+	stream = append(stream, server.StreamLoggingInterceptor(log), grpcMetrics.StreamServerInterceptor())
+	var serverOpts []grpc.ServerOption
+
+	if appCfg.TLSCert != "" {
+		certs, err := tlsconf.NewReloader(tlsconf.Files{
+			Cert: appCfg.TLSCert,
+			Key:  appCfg.TLSKey,
+			CA:   appCfg.TLSClientCA,
+		}, log)
+		if err != nil {
+			werr := errors.WithStack(err)
+			log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("tls init failed")
+			return
+		}
+		go certs.Watch(ctx, 30*time.Second)
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(certs.ServerConfig())))
+		log.WithField("mtls", appCfg.TLSClientCA != "").Info("tls enabled")
+	} else {
+		log.Warn("tls is disabled, gRPC is served in plaintext")
+	}
+	if len(appCfg.AllowedSANs) > 0 {
+		unary = append(unary, server.UnaryAuthorizeSAN(appCfg.AllowedSANs))
+		stream = append(stream, server.StreamAuthorizeSAN(appCfg.AllowedSANs))
+	}
+	// размер проверяется последним: отказы попадают в логи и метрики, а
+	// чужим клиентам не раскрываются лимиты
+	limits := server.Limits{MaxItems: appCfg.MaxItems, MaxItemBytes: appCfg.MaxItemBytes}
+	unary = append(unary, server.UnaryValidate(limits))
+	stream = append(stream, server.StreamValidate(limits))
+
+	serverOpts = append(serverOpts,
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
 	)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	alg, err := hasher.Lookup(appCfg.Algorithm)
+	if err != nil {
+		werr := errors.WithStack(err)
+		log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("hasher init failed")
+		return
+	}
+	log.WithField("algorithm", alg.Name()).WithField("backend", alg.Backend()).Info("hash algorithm selected")
+
+	pool := hasher.NewPool(appCfg.Workers, appCfg.QueueSize)
+	poolMetrics := server.NewPoolMetrics(pool)
+	prometheus.MustRegister(poolMetrics)
+	log.WithField("workers", pool.Workers()).WithField("queue_size", appCfg.QueueSize).Info("hashing pool started")
 
 	srv := &server.Server{
 		Log:         log,
 		ShutdownCtx: ctx,
+		Pool:        pool,
+		PoolMetrics: poolMetrics,
+		Algorithm:   alg,
+	}
+	if appCfg.CacheBytes > 0 {
+		srv.Cache = server.NewResultCache(appCfg.CacheBytes)
+		srv.CacheMetrics = server.NewCacheMetrics(srv.Cache)
+		prometheus.MustRegister(srv.CacheMetrics)
+		log.WithField("bytes", appCfg.CacheBytes).Info("hash result cache enabled")
 	}
 
 	hasherpb.RegisterHasherServiceServer(grpcServer, srv)
+	healthSrv := server.NewHealth()
+	healthpb.RegisterHealthServer(grpcServer, healthSrv)
+	if appCfg.Reflection {
+		reflection.Register(grpcServer)
+		log.Warn("server reflection is enabled, anyone can list the API")
+	}
 	grpcMetrics.InitializeMetrics(grpcServer)
 
 	go func() {
@@ -75,9 +165,43 @@ func main() {
 				Error("failed to serve")
 		}
 	}()
+	// всё готово: listener открыт, пул запущен
+	healthSrv.SetReady(true)
+
+	deregister := func() error { return nil }
+	if appCfg.ConsulRegister {
+		deregister, err = discovery.Register("consul:8500", discovery.Registration{
+			Name:            "service1",
+			Address:         appCfg.AdvertiseAddr,
+			Port:            50051,
+			TLS:             appCfg.TLSCert != "",
+			CheckInterval:   5 * time.Second,
+			CheckTimeout:    2 * time.Second,
+			DeregisterAfter: time.Minute,
+		})
+		if err != nil {
+			// без регистрации сервис доступен по фиксированному адресу
+			werr := errors.WithStack(err)
+			log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("consul registration failed")
+			deregister = func() error { return nil }
+		} else {
+			log.Info("registered in consul")
+		}
+	}
 
 	<-ctx.Done()
 
 	log.Infoln("Shutting down Service 1...")
+	// NOT_SERVING до GracefulStop: проверки видят остановку, пока текущие
+	// вызовы ещё дорабатывают
+	healthSrv.Shutdown()
+	// сначала из каталога, чтобы клиенты перестали выбирать этот экземпляр
+	if err := deregister(); err != nil {
+		werr := errors.WithStack(err)
+		log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("consul deregistration failed")
+	}
 	grpcServer.GracefulStop()
+	pool.Close()
 }
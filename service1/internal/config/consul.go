@@ -0,0 +1,158 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/pkg/errors"
+
+	"service1/pkg/hasher"
+)
+
+type AppConfig struct {
+	// TLSCert и TLSKey включают TLS на gRPC-сервере
+	TLSCert string
+	TLSKey  string
+	// TLSClientCA включает mTLS: клиенты должны предъявить сертификат,
+	// подписанный этим CA
+	TLSClientCA string
+	// AllowedSANs — SAN клиентских сертификатов, которым разрешены вызовы;
+	// пусто — любой клиент с сертификатом от TLSClientCA
+	AllowedSANs []string
+
+	// Clients — вызывающие сервисы по имени; пусто — аутентификация выключена
+	Clients map[string]Client
+
+	// MaxItems и MaxItemBytes ограничивают число строк в вызове и длину
+	// строки в байтах, 0 — без ограничения
+	MaxItems     int
+	MaxItemBytes int
+
+	// Workers — число воркеров хеширования на процесс, 0 — по числу CPU;
+	// QueueSize — сколько строк может ждать в их очереди
+	Workers   int
+	QueueSize int
+
+	// Algorithm — алгоритм хеширования: sha3-256 (по умолчанию) или sha256
+	Algorithm string
+	// CacheBytes — размер кэша хешей недавних строк в байтах, 0 — без кэша
+	CacheBytes int64
+
+	// ConsulRegister регистрирует экземпляр в Consul с gRPC health check;
+	// AdvertiseAddr — адрес для клиентов, пусто — IPv4 хоста
+	ConsulRegister bool
+	AdvertiseAddr  string
+
+	// Reflection включает server reflection для grpcurl, по умолчанию
+	// выключен: он доступен без аутентификации и раскрывает схему API
+	Reflection bool
+}
+
+// Client — учётные данные и права одного вызывающего сервиса. Methods —
+// полные имена методов, "/hasher.HasherService/*" или "*"; Priority — класс
+// его вызовов в очереди хеширования: low, normal (по умолчанию) или high.
+type Client struct {
+	Token      string   `json:"token"`
+	HMACSecret string   `json:"hmac_secret"`
+	Methods    []string `json:"methods"`
+	Priority   string   `json:"priority"`
+}
+
+func Load(ctx context.Context, consulAddr string) (*AppConfig, error) {
+
+	cfg := &AppConfig{}
+
+	if consulAddr == "" {
+		return nil, errors.New("consulAddr is empty")
+	}
+
+	consulConf := consulapi.DefaultConfig()
+	consulConf.Address = consulAddr
+	client, err := consulapi.NewClient(consulConf)
+	if err != nil {
+		return nil, errors.Wrap(err, "consul client failed")
+	}
+
+	kv := client.KV()
+
+	// helper: get string value by key with fallback to current value
+	getKV := func(key, current string) string {
+		opts := (&consulapi.QueryOptions{}).WithContext(ctx)
+		pair, _, err := kv.Get(key, opts)
+		if err != nil || pair == nil || len(pair.Value) == 0 {
+			return current
+		}
+		return string(pair.Value)
+	}
+
+	cfg.TLSCert = getKV("config/service1/tls_cert", cfg.TLSCert)
+	cfg.TLSKey = getKV("config/service1/tls_key", cfg.TLSKey)
+	cfg.TLSClientCA = getKV("config/service1/tls_client_ca", cfg.TLSClientCA)
+	for _, san := range strings.Split(getKV("config/service1/tls_allowed_sans", ""), ",") {
+		if san = strings.TrimSpace(san); san != "" {
+			cfg.AllowedSANs = append(cfg.AllowedSANs, san)
+		}
+	}
+
+	// config/service1/clients: {"service2":{"hmac_secret":"...","methods":["*"]}}
+	if raw := getKV("config/service1/clients", ""); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &cfg.Clients); err != nil {
+			return nil, errors.Wrap(err, "parse config/service1/clients")
+		}
+		for name, c := range cfg.Clients {
+			if c.Token == "" && c.HMACSecret == "" {
+				return nil, errors.Errorf("client %s has neither token nor hmac_secret", name)
+			}
+			if _, ok := hasher.ParsePriority(c.Priority); c.Priority != "" && !ok {
+				return nil, errors.Errorf("client %s has unknown priority %q", name, c.Priority)
+			}
+		}
+	}
+
+	cfg.MaxItems = 10000
+	if v, err := strconv.Atoi(getKV("config/service1/max_items", "")); err == nil && v >= 0 {
+		cfg.MaxItems = v
+	}
+	cfg.MaxItemBytes = 1 << 20
+	if v, err := strconv.Atoi(getKV("config/service1/max_item_bytes", "")); err == nil && v >= 0 {
+		cfg.MaxItemBytes = v
+	}
+
+	if v, err := strconv.Atoi(getKV("config/service1/workers", "")); err == nil && v > 0 {
+		cfg.Workers = v
+	}
+	cfg.QueueSize = 50000
+	if v, err := strconv.Atoi(getKV("config/service1/queue_size", "")); err == nil && v > 0 {
+		cfg.QueueSize = v
+	}
+
+	cfg.Algorithm = getKV("config/service1/algorithm", "sha3-256")
+	if v, err := strconv.ParseInt(getKV("config/service1/cache_bytes", ""), 10, 64); err == nil && v > 0 {
+		cfg.CacheBytes = v
+	}
+
+	cfg.ConsulRegister = true
+	if v, err := strconv.ParseBool(getKV("config/service1/consul_register", "")); err == nil {
+		cfg.ConsulRegister = v
+	}
+	cfg.AdvertiseAddr = getKV("config/service1/advertise_addr", cfg.AdvertiseAddr)
+
+	if v, err := strconv.ParseBool(getKV("config/service1/reflection", "")); err == nil {
+		cfg.Reflection = v
+	}
+
+	if (cfg.TLSCert == "") != (cfg.TLSKey == "") {
+		return nil, errors.New("tls_cert and tls_key must be set together")
+	}
+	if cfg.TLSClientCA != "" && cfg.TLSCert == "" {
+		return nil, errors.New("tls_client_ca requires tls_cert and tls_key")
+	}
+	if len(cfg.AllowedSANs) > 0 && cfg.TLSClientCA == "" {
+		return nil, errors.New("tls_allowed_sans requires tls_client_ca")
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,88 @@
+// Package discovery registers service1 in Consul, so that clients find every
+// healthy instance instead of one fixed address.
+package discovery
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/pkg/errors"
+)
+
+// Registration describes this instance in the Consul catalog.
+type Registration struct {
+	// Name is the service name clients resolve, e.g. "service1".
+	Name string
+	// Address is where other hosts reach the gRPC server; empty means the
+	// first non-loopback IPv4 address of this host.
+	Address string
+	Port    int
+	// TLS makes the health check connect over TLS.
+	TLS bool
+
+	// CheckInterval and CheckTimeout drive the gRPC health check.
+	// DeregisterAfter removes an instance whose check stays critical for
+	// that long, e.g. after a crash without deregistration.
+	CheckInterval   time.Duration
+	CheckTimeout    time.Duration
+	DeregisterAfter time.Duration
+}
+
+// Register adds the instance with a gRPC health check to the agent at
+// consulAddr. The check calls grpc.health.v1.Health/Check, which the server
+// must serve. The returned function removes the instance again.
+func Register(consulAddr string, r Registration) (deregister func() error, err error) {
+	if r.Address == "" {
+		if r.Address, err = hostAddress(); err != nil {
+			return nil, err
+		}
+	}
+	conf := consulapi.DefaultConfig()
+	conf.Address = consulAddr
+	client, err := consulapi.NewClient(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "consul client failed")
+	}
+
+	id := fmt.Sprintf("%s-%s-%d", r.Name, r.Address, r.Port)
+	reg := &consulapi.AgentServiceRegistration{
+		ID:      id,
+		Name:    r.Name,
+		Address: r.Address,
+		Port:    r.Port,
+		Check: &consulapi.AgentServiceCheck{
+			Name:       r.Name + " grpc health",
+			GRPC:       net.JoinHostPort(r.Address, strconv.Itoa(r.Port)),
+			GRPCUseTLS: r.TLS,
+			// проверка не передаёт секретов, а сертификат сервера может быть
+			// выпущен CA, которого агент не знает
+			TLSSkipVerify:                  r.TLS,
+			Interval:                       r.CheckInterval.String(),
+			Timeout:                        r.CheckTimeout.String(),
+			DeregisterCriticalServiceAfter: r.DeregisterAfter.String(),
+		},
+	}
+	if err := client.Agent().ServiceRegister(reg); err != nil {
+		return nil, errors.Wrapf(err, "register %s in consul", id)
+	}
+	return func() error {
+		return errors.Wrapf(client.Agent().ServiceDeregister(id), "deregister %s from consul", id)
+	}, nil
+}
+
+// hostAddress returns the first non-loopback IPv4 address of the host.
+func hostAddress() (string, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return "", errors.Wrap(err, "list interface addresses")
+	}
+	for _, a := range addrs {
+		if ipnet, ok := a.(*net.IPNet); ok && !ipnet.IP.IsLoopback() && ipnet.IP.To4() != nil {
+			return ipnet.IP.String(), nil
+		}
+	}
+	return "", errors.New("no non-loopback IPv4 address to advertise")
+}
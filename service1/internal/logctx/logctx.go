@@ -8,6 +8,9 @@ import (
 
 const RequestIDKey = "x-request-id"
 
+// SubjectKey — метаданные с пользователем, от имени которого service2 делает вызов.
+const SubjectKey = "x-subject"
+
 // EnsureRequestID возвращает существующий reqID из ctx или создает новый.
 func EnsureRequestID(ctx context.Context) (context.Context, string) {
 	// gRPC metadata -> x-request-id
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"service1/pkg/hasher"
+)
+
+// Метаданные, которыми клиент подтверждает свою личность.
+const (
+	// MDAuthorization carries a static token as "Bearer <token>".
+	MDAuthorization = "authorization"
+	// MDClientID, MDTimestamp, MDNonce and MDSignature carry an HMAC-signed
+	// call, see SignCall.
+	MDClientID  = "x-client-id"
+	MDTimestamp = "x-timestamp"
+	MDNonce     = "x-nonce"
+	MDSignature = "x-signature"
+)
+
+// MaxClockSkew is how far the timestamp of a signed call may be from the
+// server clock. Nonces are remembered while their timestamp is accepted, so
+// a captured signature cannot be replayed to the same instance.
+const MaxClockSkew = 5 * time.Minute
+
+// Client is a caller of the API. It authenticates with Token, with
+// HMACSecret or with either of them if both are set. Methods lists the full
+// method names it may call; "/pkg.Service/*" allows every method of a
+// service and "*" allows everything. Priority is the class of its calls in
+// the hashing pool: "low", "normal" (also when empty) or "high".
+type Client struct {
+	Name       string
+	Token      string
+	HMACSecret string
+	Methods    []string
+	Priority   string
+}
+
+// Authenticator identifies callers and checks their per-method permissions.
+type Authenticator struct {
+	log     *logrus.Logger
+	clients map[string]Client
+	// tokens maps the SHA-256 of a token to the client name so that tokens
+	// are not compared byte by byte
+	tokens map[string]string
+	nonces *nonceCache
+}
+
+func NewAuthenticator(clients []Client, log *logrus.Logger) *Authenticator {
+	a := &Authenticator{
+		log:     log,
+		clients: make(map[string]Client, len(clients)),
+		tokens:  make(map[string]string, len(clients)),
+		nonces:  newNonceCache(2 * MaxClockSkew),
+	}
+	for _, c := range clients {
+		a.clients[c.Name] = c
+		if c.Token != "" {
+			a.tokens[tokenHash(c.Token)] = c.Name
+		}
+	}
+	return a
+}
+
+type ctxKeyClient struct{}
+
+// ClientFromContext returns the name of the authenticated caller, or "".
+func ClientFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(ctxKeyClient{}).(string)
+	return name
+}
+
+type ctxKeyPriority struct{}
+
+// PriorityFromContext returns the priority of the authenticated caller. It is
+// normal for unauthenticated calls; clients cannot choose it themselves, or
+// any of them could take the whole queue.
+func PriorityFromContext(ctx context.Context) hasher.Priority {
+	if p, ok := ctx.Value(ctxKeyPriority{}).(hasher.Priority); ok {
+		return p
+	}
+	return hasher.PriorityNormal
+}
+
+// publicServices — сервисы, доступные без аутентификации: health вызывают
+// Consul и оркестратор, у которых нет учётных данных клиентов, reflection —
+// grpcurl при отладке
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// public reports whether method may be called by anyone.
+func public(method string) bool {
+	for _, prefix := range publicServices {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryAuth rejects calls from unknown callers with Unauthenticated and calls
+// to methods the caller is not allowed with PermissionDenied. The caller name
+// is added to the request logger and logging fields. Health checks and
+// reflection are not authenticated.
+func UnaryAuth(a *Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if public(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth is the streaming counterpart of UnaryAuth.
+func StreamAuth(a *Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if public(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		// сообщения потока ещё не получены, подпись покрывает только открытие
+		ctx, err := a.authorize(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+func (a *Authenticator) authorize(ctx context.Context, method string, req any) (context.Context, error) {
+	entry := GetLoggerFromCtx(ctx, a.log).WithField("method", method)
+
+	name, err := a.identify(ctx, method, req)
+	if err != nil {
+		entry.WithError(err).Warn("auth: call rejected")
+		return nil, err
+	}
+	entry = entry.WithField("client", name)
+	if !allowed(a.clients[name].Methods, method) {
+		entry.Warn("auth: method not allowed")
+		return nil, status.Errorf(codes.PermissionDenied, "client %s may not call %s", name, method)
+	}
+
+	prio, _ := hasher.ParsePriority(a.clients[name].Priority)
+	ctx = context.WithValue(ctx, ctxKeyClient{}, name)
+	ctx = context.WithValue(ctx, ctxKeyPriority{}, prio)
+	ctx = context.WithValue(ctx, ctxKeyLogger{}, GetLoggerFromCtx(ctx, a.log).WithField("client", name))
+	return logging.InjectFields(ctx, logging.Fields{"client", name}), nil
+}
+
+func (a *Authenticator) identify(ctx context.Context, method string, req any) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if vals := md.Get(key); len(vals) > 0 {
+			return vals[0]
+		}
+		return ""
+	}
+
+	if id := first(MDClientID); id != "" {
+		c, ok := a.clients[id]
+		if !ok || c.HMACSecret == "" {
+			return "", status.Error(codes.Unauthenticated, "unknown client")
+		}
+		ts := first(MDTimestamp)
+		sec, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return "", status.Error(codes.Unauthenticated, "bad timestamp")
+		}
+		if skew := time.Since(time.Unix(sec, 0)); skew > MaxClockSkew || skew < -MaxClockSkew {
+			return "", status.Error(codes.Unauthenticated, "timestamp outside of allowed clock skew")
+		}
+		nonce := first(MDNonce)
+		if nonce == "" {
+			return "", status.Error(codes.Unauthenticated, "nonce required")
+		}
+		got, err := hex.DecodeString(first(MDSignature))
+		if err != nil || !hmac.Equal(got, signature(c.HMACSecret, id, method, ts, nonce, req)) {
+			return "", status.Error(codes.Unauthenticated, "bad signature")
+		}
+		// nonce запоминается только после проверки подписи, иначе чужой
+		// запрос мог бы занять его заранее
+		if !a.nonces.add(id + "\n" + nonce) {
+			return "", status.Error(codes.Unauthenticated, "replayed call")
+		}
+		return id, nil
+	}
+
+	if scheme, token, ok := strings.Cut(first(MDAuthorization), " "); ok && strings.EqualFold(scheme, "Bearer") {
+		if name, ok := a.tokens[tokenHash(token)]; ok {
+			return name, nil
+		}
+		return "", status.Error(codes.Unauthenticated, "unknown token")
+	}
+
+	return "", status.Error(codes.Unauthenticated, "credentials required")
+}
+
+func allowed(patterns []string, method string) bool {
+	for _, p := range patterns {
+		if p == "*" || p == method {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(p, "*"); ok && strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignCall returns the metadata that authenticates a call of method with
+// request req by client with secret at time now. The signature is
+// HMAC-SHA256 over "client\nmethod\ntimestamp\nnonce\ndigest" in hex, where
+// nonce is random and digest is the hex SHA-256 of req in deterministic
+// protobuf encoding. req is nil for streams, whose messages are not signed.
+func SignCall(client, secret, method string, req proto.Message, now time.Time) metadata.MD {
+	ts := strconv.FormatInt(now.Unix(), 10)
+	nonce := rand.Text()
+	return metadata.Pairs(
+		MDClientID, client,
+		MDTimestamp, ts,
+		MDNonce, nonce,
+		MDSignature, hex.EncodeToString(signature(secret, client, method, ts, nonce, req)),
+	)
+}
+
+func signature(secret, client, method, ts, nonce string, req any) []byte {
+	var body []byte
+	if m, ok := req.(proto.Message); ok && m != nil {
+		// ошибка кодирования даёт пустое тело и, значит, неверную подпись
+		body, _ = proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	}
+	digest := sha256.Sum256(body)
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(client + "\n" + method + "\n" + ts + "\n" + nonce + "\n" + hex.EncodeToString(digest[:])))
+	return m.Sum(nil)
+}
+
+// nonceCache remembers nonces for at least ttl in two generations: the older
+// one is dropped as a whole once the newer one is ttl old.
+type nonceCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	cur     map[string]struct{}
+	prev    map[string]struct{}
+	rotated time.Time
+}
+
+func newNonceCache(ttl time.Duration) *nonceCache {
+	return &nonceCache{ttl: ttl, cur: map[string]struct{}{}, prev: map[string]struct{}{}, rotated: time.Now()}
+}
+
+// add records nonce and reports whether it was not seen before.
+func (n *nonceCache) add(nonce string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if time.Since(n.rotated) >= n.ttl {
+		n.prev, n.cur, n.rotated = n.cur, map[string]struct{}{}, time.Now()
+	}
+	if _, ok := n.cur[nonce]; ok {
+		return false
+	}
+	if _, ok := n.prev[nonce]; ok {
+		return false
+	}
+	n.cur[nonce] = struct{}{}
+	return true
+}
+
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,167 @@
+package server_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"service1/internal/server"
+	"service1/pkg/hasher"
+	"service1/proto/hasherpb"
+)
+
+const methodCalculate = "/hasher.HasherService/CalculateHashes"
+
+func startAuthGRPC(t *testing.T, clients ...server.Client) hasherpb.HasherServiceClient {
+	t.Helper()
+
+	logger := logrus.New()
+	auth := server.NewAuthenticator(clients, logger)
+	return hasherpb.NewHasherServiceClient(startBufGRPC(t, nil,
+		grpc.ChainUnaryInterceptor(server.UnaryRequestID(logger), server.UnaryAuth(auth)),
+		grpc.ChainStreamInterceptor(server.StreamRequestID(logger), server.StreamAuth(auth)),
+	))
+}
+
+// callReq is the request callWith sends.
+var callReq = &hasherpb.HashRequest{Strings: []string{"a"}}
+
+func callWith(client hasherpb.HasherServiceClient, md metadata.MD) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, md)
+	_, err := client.CalculateHashes(ctx, callReq)
+	return err
+}
+
+func TestAuth_StaticToken(t *testing.T) {
+	client := startAuthGRPC(t,
+		server.Client{Name: "etl", Token: "etl-token", Methods: []string{"/hasher.HasherService/*"}},
+		server.Client{Name: "stream-only", Token: "other-token", Methods: []string{"/hasher.HasherService/CalculateHashesStream"}},
+	)
+
+	require.NoError(t, callWith(client, metadata.Pairs("authorization", "Bearer etl-token")))
+	require.Equal(t, codes.Unauthenticated, status.Code(callWith(client, metadata.Pairs("authorization", "Bearer wrong"))))
+	require.Equal(t, codes.Unauthenticated, status.Code(callWith(client, nil)))
+	require.Equal(t, codes.PermissionDenied, status.Code(callWith(client, metadata.Pairs("authorization", "Bearer other-token"))))
+}
+
+func TestAuth_HMAC(t *testing.T) {
+	client := startAuthGRPC(t, server.Client{Name: "service2", HMACSecret: "s3cret", Methods: []string{"*"}})
+
+	signed := server.SignCall("service2", "s3cret", methodCalculate, callReq, time.Now())
+	require.NoError(t, callWith(client, signed))
+
+	// подпись привязана к методу, секрету, телу запроса и времени
+	bad := []metadata.MD{
+		server.SignCall("service2", "wrong", methodCalculate, callReq, time.Now()),
+		server.SignCall("service2", "s3cret", "/hasher.HasherService/CalculateHashesStream", callReq, time.Now()),
+		server.SignCall("service2", "s3cret", methodCalculate, &hasherpb.HashRequest{Strings: []string{"b"}}, time.Now()),
+		server.SignCall("service2", "s3cret", methodCalculate, callReq, time.Now().Add(-server.MaxClockSkew-time.Minute)),
+		server.SignCall("unknown", "s3cret", methodCalculate, callReq, time.Now()),
+	}
+	for i, md := range bad {
+		require.Equal(t, codes.Unauthenticated, status.Code(callWith(client, md)), i)
+	}
+
+	md := server.SignCall("service2", "s3cret", methodCalculate, callReq, time.Now())
+	md.Set(server.MDTimestamp, strconv.FormatInt(time.Now().Unix()+1, 10))
+	require.Equal(t, codes.Unauthenticated, status.Code(callWith(client, md)))
+
+	md = server.SignCall("service2", "s3cret", methodCalculate, callReq, time.Now())
+	md.Set(server.MDNonce, "other")
+	require.Equal(t, codes.Unauthenticated, status.Code(callWith(client, md)))
+
+	// перехваченный вызов нельзя повторить
+	require.Equal(t, codes.Unauthenticated, status.Code(callWith(client, signed)))
+}
+
+func TestAuth_HMACStream(t *testing.T) {
+	client := startAuthGRPC(t, server.Client{Name: "service2", HMACSecret: "s3cret", Methods: []string{"*"}})
+	method := "/hasher.HasherService/CalculateHashesStream"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	signed := server.SignCall("service2", "s3cret", method, nil, time.Now())
+	for i, want := range []codes.Code{codes.OK, codes.Unauthenticated} {
+		st, err := client.CalculateHashesStream(metadata.NewOutgoingContext(ctx, signed))
+		require.NoError(t, err)
+		_, err = st.CloseAndRecv()
+		require.Equal(t, want, status.Code(err), i)
+	}
+}
+
+func TestAuth_Stream(t *testing.T) {
+	client := startAuthGRPC(t, server.Client{Name: "etl", Token: "etl-token", Methods: []string{methodCalculate}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer etl-token")
+	st, err := client.CalculateHashesStream(ctx)
+	require.NoError(t, err)
+	_, err = st.CloseAndRecv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuth_Priority(t *testing.T) {
+	logger := logrus.New()
+	auth := server.NewAuthenticator([]server.Client{
+		{Name: "batch", Token: "batch-token", Methods: []string{"*"}, Priority: "low"},
+		{Name: "etl", Token: "etl-token", Methods: []string{"*"}},
+	}, logger)
+	var got hasher.Priority
+	capture := func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		got = server.PriorityFromContext(ctx)
+		return handler(ctx, req)
+	}
+	client := hasherpb.NewHasherServiceClient(startBufGRPC(t, nil, grpc.ChainUnaryInterceptor(server.UnaryAuth(auth), capture)))
+
+	// приоритет задаётся конфигурацией клиента, а не его метаданными
+	require.NoError(t, callWith(client, metadata.Pairs("authorization", "Bearer batch-token", "x-priority", "high")))
+	require.Equal(t, hasher.PriorityLow, got)
+	require.NoError(t, callWith(client, metadata.Pairs("authorization", "Bearer etl-token", "x-priority", "high")))
+	require.Equal(t, hasher.PriorityNormal, got)
+}
+
+func TestAuth_PublicServices(t *testing.T) {
+	logger := logrus.New()
+	auth := server.NewAuthenticator([]server.Client{{Name: "etl", Token: "etl-token", Methods: []string{"*"}}}, logger)
+
+	h := server.NewHealth()
+	h.SetReady(true)
+	conn := startBufGRPC(t, func(s *grpc.Server) {
+		healthpb.RegisterHealthServer(s, h)
+		reflection.Register(s)
+	},
+		grpc.ChainUnaryInterceptor(server.UnaryAuth(auth)),
+		grpc.ChainStreamInterceptor(server.StreamAuth(auth)),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	// Consul проверяет здоровье без учётных данных
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	// grpcurl видит сервисы без учётных данных
+	st, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, st.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	info, err := st.Recv()
+	require.NoError(t, err)
+	require.NotEmpty(t, info.GetListServicesResponse().GetService())
+}
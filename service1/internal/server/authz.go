@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryAuthorizeSAN admits only callers whose verified client certificate has
+// one of the allowed SANs: a DNS name, a URI such as a SPIFFE ID, an e-mail
+// address or an IP address. It requires the server to run with mutual TLS.
+// Health checks and reflection are admitted from any peer.
+func UnaryAuthorizeSAN(allowed []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if public(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if err := authorizeSAN(ctx, allowed); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthorizeSAN is the streaming counterpart of UnaryAuthorizeSAN.
+func StreamAuthorizeSAN(allowed []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if public(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		if err := authorizeSAN(ss.Context(), allowed); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorizeSAN(ctx context.Context, allowed []string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "no peer")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return status.Error(codes.Unauthenticated, "client certificate required")
+	}
+	for _, san := range certSANs(info.State.VerifiedChains[0][0]) {
+		if slices.Contains(allowed, san) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "client certificate is not allowed")
+}
+
+func certSANs(c *x509.Certificate) []string {
+	sans := make([]string, 0, len(c.DNSNames)+len(c.URIs)+len(c.EmailAddresses)+len(c.IPAddresses))
+	sans = append(sans, c.DNSNames...)
+	for _, u := range c.URIs {
+		sans = append(sans, u.String())
+	}
+	sans = append(sans, c.EmailAddresses...)
+	for _, ip := range c.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	return sans
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"container/list"
+	"hash/maphash"
+	"strings"
+	"sync"
+)
+
+// entryOverhead approximates the memory an entry takes besides its strings:
+// the list element, the map slot and the string headers.
+const entryOverhead = 128
+
+// ResultCache remembers the hashes of recently hashed strings, up to a
+// number of bytes, and evicts the least recently used ones. Entries are
+// keyed by a digest of the algorithm and the input; the input is kept as
+// well, so a digest collision is a miss and never a wrong hash.
+type ResultCache struct {
+	maxBytes int64
+	seed     maphash.Seed
+
+	mu    sync.Mutex
+	bytes int64
+	items map[uint64]*list.Element
+	order *list.List // от недавних к давним
+}
+
+type resultEntry struct {
+	key   uint64
+	input string
+	hash  string
+}
+
+// NewResultCache returns a cache of up to maxBytes.
+func NewResultCache(maxBytes int64) *ResultCache {
+	return &ResultCache{maxBytes: maxBytes, seed: maphash.MakeSeed(), items: make(map[uint64]*list.Element), order: list.New()}
+}
+
+// Get returns the cached hashes of strs by algorithm alg; a miss gives ""
+// and its index in miss.
+func (c *ResultCache) Get(alg string, strs []string) (out []string, miss []int) {
+	out = make([]string, len(strs))
+	keys := make([]uint64, len(strs))
+	for i, s := range strs {
+		keys[i] = c.digest(alg, s)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, s := range strs {
+		el, ok := c.items[keys[i]]
+		if !ok || el.Value.(*resultEntry).input != s {
+			miss = append(miss, i)
+			continue
+		}
+		c.order.MoveToFront(el)
+		out[i] = el.Value.(*resultEntry).hash
+	}
+	return out, miss
+}
+
+// Put stores copies of hashes[i] as the hash of strs[i] by algorithm alg, so
+// that the cache holds no more memory than it counts. Strings
+// taking more than a sixteenth of the cache are not stored, so that one big
+// input does not evict everything else.
+func (c *ResultCache) Put(alg string, strs, hashes []string) {
+	keys := make([]uint64, len(strs))
+	for i, s := range strs {
+		keys[i] = c.digest(alg, s)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, s := range strs {
+		size := entrySize(s, hashes[i])
+		if size > c.maxBytes/16 {
+			continue
+		}
+		if el, ok := c.items[keys[i]]; ok {
+			c.remove(el)
+		}
+		// копии: хеш может быть подстрокой хешей целой пачки, а строка —
+		// частью запроса, и запись удерживала бы их целиком
+		c.items[keys[i]] = c.order.PushFront(&resultEntry{key: keys[i], input: strings.Clone(s), hash: strings.Clone(hashes[i])})
+		c.bytes += size
+		for c.bytes > c.maxBytes {
+			c.remove(c.order.Back())
+		}
+	}
+}
+
+// Bytes returns the approximate size of the cached entries.
+func (c *ResultCache) Bytes() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bytes
+}
+
+// Len returns the number of cached entries.
+func (c *ResultCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *ResultCache) digest(alg, s string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(c.seed)
+	h.WriteString(alg)
+	h.WriteByte(0)
+	h.WriteString(s)
+	return h.Sum64()
+}
+
+func (c *ResultCache) remove(el *list.Element) {
+	e := el.Value.(*resultEntry)
+	c.order.Remove(el)
+	delete(c.items, e.key)
+	c.bytes -= entrySize(e.input, e.hash)
+}
+
+func entrySize(input, hash string) int64 {
+	return int64(len(input) + len(hash) + entryOverhead)
+}
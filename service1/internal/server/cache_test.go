@@ -0,0 +1,102 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service1/internal/server"
+	"service1/pkg/hasher"
+	"service1/proto/hasherpb"
+)
+
+func TestResultCache_EvictsByBytes(t *testing.T) {
+	// запись со строкой в 100 байт занимает ~230 байт, в кэш их входит 17
+	c := server.NewResultCache(4096)
+	in := func(i int) string { return fmt.Sprintf("%0100d", i) }
+	for i := range 20 {
+		c.Put("sha256", []string{in(i)}, []string{strconv.Itoa(i)})
+	}
+	require.Equal(t, 17, c.Len())
+	require.LessOrEqual(t, c.Bytes(), int64(4096))
+	out, miss := c.Get("sha256", []string{in(0), in(2), in(3), in(19)})
+	require.Equal(t, []string{"", "", "3", "19"}, out)
+	require.Equal(t, []int{0, 1}, miss)
+
+	// in(3) только что прочитана, вытесняется следующая за ней
+	c.Put("sha256", []string{in(20)}, []string{"20"})
+	_, miss = c.Get("sha256", []string{in(3), in(4)})
+	require.Equal(t, []int{1}, miss)
+
+	// алгоритм входит в ключ
+	_, miss = c.Get("sha3-256", []string{in(3)})
+	require.Equal(t, []int{0}, miss)
+
+	// строки больше шестнадцатой части кэша не кэшируются
+	c.Put("sha256", []string{strings.Repeat("x", 200)}, []string{"x"})
+	_, miss = c.Get("sha256", []string{strings.Repeat("x", 200)})
+	require.Equal(t, []int{0}, miss)
+}
+
+func TestResultCache_RetainedHeap(t *testing.T) {
+	const maxBytes = 64 << 10
+	c := server.NewResultCache(maxBytes)
+	heap := func() uint64 {
+		runtime.GC()
+		var m runtime.MemStats
+		runtime.ReadMemStats(&m)
+		return m.HeapAlloc
+	}
+	before := heap()
+
+	// хеши пачки — подстроки одной большой строки, как у hasher.HashStrings
+	for i := range 1000 {
+		chunk := strings.Repeat(strconv.Itoa(i%10), 64<<10)
+		c.Put("sha256", []string{strconv.Itoa(i)}, []string{chunk[:64]})
+	}
+	after := heap()
+	require.Less(t, int64(after)-int64(before), int64(4*maxBytes))
+	runtime.KeepAlive(c)
+}
+
+func TestResultCache_CopiesStrings(t *testing.T) {
+	c := server.NewResultCache(4096)
+	batch := strings.Repeat("ab", 64)
+	in := "key"
+	c.Put("sha256", []string{in}, []string{batch[:64]})
+
+	// запись не должна ссылаться на память пачки
+	out, _ := c.Get("sha256", []string{in})
+	require.Equal(t, batch[:64], out[0])
+	require.NotSame(t, unsafe.StringData(batch), unsafe.StringData(out[0]))
+}
+
+func TestCalculateHashes_Cache(t *testing.T) {
+	c := server.NewResultCache(1 << 20)
+	srv := &server.Server{Log: logrus.New(), Algorithm: hasher.SHA256, Cache: c, CacheMetrics: server.NewCacheMetrics(c)}
+	require.NoError(t, prometheus.NewRegistry().Register(srv.CacheMetrics))
+	ctx := context.Background()
+
+	uncached := &server.Server{Log: logrus.New(), Algorithm: hasher.SHA256}
+	want, err := uncached.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"a", "b", "c"}})
+	require.NoError(t, err)
+
+	resp, err := srv.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"a", "b"}})
+	require.NoError(t, err)
+	require.Equal(t, want.GetHashes()[:2], resp.GetHashes())
+	require.Equal(t, 2, c.Len())
+
+	// закэшированные и новые строки вперемешку
+	resp, err = srv.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"c", "a", "b"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{want.GetHashes()[2], want.GetHashes()[0], want.GetHashes()[1]}, resp.GetHashes())
+	require.Equal(t, 3, c.Len())
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"service1/proto/hasherpb"
+)
+
+// Health serves grpc.health.v1 for the whole server ("") and for the hasher
+// service. It reports NOT_SERVING until the server is ready and again from
+// Shutdown on, so that Consul and the orchestrator stop sending calls before
+// the server stops taking them.
+type Health struct {
+	*health.Server
+}
+
+// healthServices — имена, по которым можно спросить статус
+var healthServices = []string{"", hasherpb.HasherService_ServiceDesc.ServiceName}
+
+// NewHealth returns a health service that is not serving yet.
+func NewHealth() *Health {
+	h := &Health{Server: health.NewServer()}
+	h.SetReady(false)
+	return h
+}
+
+// SetReady reports SERVING or NOT_SERVING. It has no effect after Shutdown.
+func (h *Health) SetReady(ready bool) {
+	st := healthpb.HealthCheckResponse_NOT_SERVING
+	if ready {
+		st = healthpb.HealthCheckResponse_SERVING
+	}
+	for _, name := range healthServices {
+		h.SetServingStatus(name, st)
+	}
+}
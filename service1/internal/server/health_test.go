@@ -0,0 +1,41 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"service1/internal/server"
+	"service1/proto/hasherpb"
+)
+
+func TestHealth_FollowsReadiness(t *testing.T) {
+	h := server.NewHealth()
+
+	conn := startBufGRPC(t, func(s *grpc.Server) { healthpb.RegisterHealthServer(s, h) })
+	client := healthpb.NewHealthClient(conn)
+
+	check := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		t.Helper()
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return resp.GetStatus()
+	}
+	hasher := hasherpb.HasherService_ServiceDesc.ServiceName
+
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(""))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(hasher))
+
+	h.SetReady(true)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, check(""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, check(hasher))
+
+	// после Shutdown готовность уже не возвращается
+	h.Shutdown()
+	h.SetReady(true)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(""))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(hasher))
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -18,27 +19,45 @@ type ctxKeyLogger struct{}
 // request id in the context so that handlers can retrieve it.
 func UnaryRequestID(log *logrus.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if vals := md.Get(logctx.RequestIDKey); len(vals) > 0 && vals[0] != "" {
-				ctx = context.WithValue(ctx, logctx.RequestIDKey, vals[0])
-			}
-		}
+		return handler(withRequestID(ctx, log), req)
+	}
+}
 
-		ctx, reqID := logctx.EnsureRequestID(ctx)
-		entry := log.WithFields(logrus.Fields{
-			"request_id": reqID,
-			"component":  "service1",
-		})
+// StreamRequestID is the streaming counterpart of UnaryRequestID.
+func StreamRequestID(log *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withRequestID(ss.Context(), log)
+		return handler(srv, wrapped)
+	}
+}
 
-		// store entry for handlers and inject fields for logging interceptor
-		ctx = context.WithValue(ctx, ctxKeyLogger{}, entry)
-		ctx = logging.InjectFields(ctx, logging.Fields{
-			"request_id", reqID,
-			"component", "service1",
-		})
+func withRequestID(ctx context.Context, log *logrus.Logger) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(logctx.RequestIDKey); len(vals) > 0 && vals[0] != "" {
+			ctx = context.WithValue(ctx, logctx.RequestIDKey, vals[0])
+		}
+	}
 
-		return handler(ctx, req)
+	ctx, reqID := logctx.EnsureRequestID(ctx)
+	entry := log.WithFields(logrus.Fields{
+		"request_id": reqID,
+		"component":  "service1",
+	})
+	fields := logging.Fields{
+		"request_id", reqID,
+		"component", "service1",
 	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(logctx.SubjectKey); len(vals) > 0 && vals[0] != "" {
+			entry = entry.WithField("subject", vals[0])
+			fields = append(fields, "subject", vals[0])
+		}
+	}
+
+	// store entry for handlers and inject fields for logging interceptor
+	ctx = context.WithValue(ctx, ctxKeyLogger{}, entry)
+	return logging.InjectFields(ctx, fields)
 }
 
 func GetLoggerFromCtx(ctx context.Context, base *logrus.Logger) *logrus.Entry {
@@ -54,7 +73,23 @@ func GetLoggerFromCtx(ctx context.Context, base *logrus.Logger) *logrus.Entry {
 // LoggingInterceptor returns a unary server interceptor from the
 // go-grpc-middleware logging package configured with the provided logger.
 func LoggingInterceptor(log *logrus.Logger) grpc.UnaryServerInterceptor {
-	logger := logging.LoggerFunc(func(ctx context.Context, level logging.Level, msg string, fields ...any) {
+	return logging.UnaryServerInterceptor(logrusLogger(log), loggingOptions()...)
+}
+
+// StreamLoggingInterceptor is the streaming counterpart of LoggingInterceptor.
+func StreamLoggingInterceptor(log *logrus.Logger) grpc.StreamServerInterceptor {
+	return logging.StreamServerInterceptor(logrusLogger(log), loggingOptions()...)
+}
+
+func loggingOptions() []logging.Option {
+	return []logging.Option{
+		logging.WithFieldsFromContext(logging.ExtractFields),
+		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
+	}
+}
+
+func logrusLogger(log *logrus.Logger) logging.Logger {
+	return logging.LoggerFunc(func(ctx context.Context, level logging.Level, msg string, fields ...any) {
 		entry := log.WithContext(ctx)
 		for i := 0; i+1 < len(fields); i += 2 {
 			key, ok := fields[i].(string)
@@ -76,8 +111,4 @@ func LoggingInterceptor(log *logrus.Logger) grpc.UnaryServerInterceptor {
 			entry.Info(msg)
 		}
 	})
-	return logging.UnaryServerInterceptor(logger,
-		logging.WithFieldsFromContext(logging.ExtractFields),
-		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
-	)
 }
@@ -2,6 +2,9 @@ package server
 
 import (
 	prom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"service1/pkg/hasher"
 )
 
 func NewServerMetrics() *prom.ServerMetrics {
@@ -9,3 +12,86 @@ func NewServerMetrics() *prom.ServerMetrics {
 		prom.WithServerHandlingTimeHistogram(),
 	)
 }
+
+// PoolMetrics exposes the load of a hasher.Pool and counts the calls it
+// rejected.
+type PoolMetrics struct {
+	pool     *hasher.Pool
+	queued   *prometheus.Desc
+	busy     *prometheus.Desc
+	workers  *prometheus.Desc
+	rejected *prometheus.CounterVec
+}
+
+func NewPoolMetrics(p *hasher.Pool) *PoolMetrics {
+	return &PoolMetrics{
+		pool:    p,
+		queued:  prometheus.NewDesc("service1_pool_queued_strings", "Strings waiting in the hashing queue.", nil, nil),
+		busy:    prometheus.NewDesc("service1_pool_busy_workers", "Hashing workers currently busy.", nil, nil),
+		workers: prometheus.NewDesc("service1_pool_workers", "Hashing workers in the pool.", nil, nil),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "service1_pool_rejected_total",
+			Help: "Calls rejected with ResourceExhausted because the hashing queue was full.",
+		}, []string{"priority"}),
+	}
+}
+
+func (m *PoolMetrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.queued
+	ch <- m.busy
+	ch <- m.workers
+	m.rejected.Describe(ch)
+}
+
+func (m *PoolMetrics) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(m.queued, prometheus.GaugeValue, float64(m.pool.Queued()))
+	ch <- prometheus.MustNewConstMetric(m.busy, prometheus.GaugeValue, float64(m.pool.Busy()))
+	ch <- prometheus.MustNewConstMetric(m.workers, prometheus.GaugeValue, float64(m.pool.Workers()))
+	m.rejected.Collect(ch)
+}
+
+func (m *PoolMetrics) reject(prio hasher.Priority) {
+	if m != nil {
+		m.rejected.WithLabelValues(prio.String()).Inc()
+	}
+}
+
+// CacheMetrics exposes the size of a ResultCache and counts its hits and
+// misses.
+type CacheMetrics struct {
+	cache   *ResultCache
+	bytes   *prometheus.Desc
+	entries *prometheus.Desc
+	lookups *prometheus.CounterVec
+}
+
+func NewCacheMetrics(c *ResultCache) *CacheMetrics {
+	return &CacheMetrics{
+		cache:   c,
+		bytes:   prometheus.NewDesc("service1_cache_bytes", "Approximate size of the hash result cache in bytes.", nil, nil),
+		entries: prometheus.NewDesc("service1_cache_entries", "Entries in the hash result cache.", nil, nil),
+		lookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "service1_cache_lookups_total",
+			Help: "Strings looked up in the hash result cache, by result (hit, miss).",
+		}, []string{"result"}),
+	}
+}
+
+func (m *CacheMetrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.bytes
+	ch <- m.entries
+	m.lookups.Describe(ch)
+}
+
+func (m *CacheMetrics) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(m.bytes, prometheus.GaugeValue, float64(m.cache.Bytes()))
+	ch <- prometheus.MustNewConstMetric(m.entries, prometheus.GaugeValue, float64(m.cache.Len()))
+	m.lookups.Collect(ch)
+}
+
+func (m *CacheMetrics) lookup(hits, misses int) {
+	if m != nil {
+		m.lookups.WithLabelValues("hit").Add(float64(hits))
+		m.lookups.WithLabelValues("miss").Add(float64(misses))
+	}
+}
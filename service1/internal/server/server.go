@@ -5,14 +5,40 @@ import (
 	"fmt"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
 	"service1/pkg/hasher"
 	"service1/proto/hasherpb"
 )
 
+// MDAlgorithm is the response header with the name of the algorithm the
+// hashes were computed with, so that clients keeping hashes can tell when it
+// changes.
+const MDAlgorithm = "x-hash-algorithm"
+
 type Server struct {
 	hasherpb.UnimplementedHasherServiceServer
 	Log         *logrus.Logger
 	ShutdownCtx context.Context
+	// Pool, если задан, хеширует строки всех вызовов на общих воркерах;
+	// без него каждый вызов запускает свои горутины
+	Pool        *hasher.Pool
+	PoolMetrics *PoolMetrics
+	// Algorithm — алгоритм хеширования, по умолчанию SHA3-256
+	Algorithm hasher.Algorithm
+	// Cache, если задан, хранит хеши недавних строк CalculateHashes
+	Cache        *ResultCache
+	CacheMetrics *CacheMetrics
+}
+
+func (s *Server) algorithm() hasher.Algorithm {
+	if s.Algorithm == nil {
+		return hasher.SHA3_256
+	}
+	return s.Algorithm
 }
 
 func (s *Server) CalculateHashes(reqCtx context.Context, req *hasherpb.HashRequest) (*hasherpb.HashResponse, error) {
@@ -29,11 +55,17 @@ func (s *Server) CalculateHashes(reqCtx context.Context, req *hasherpb.HashReque
 
 	log := GetLoggerFromCtx(ctx, s.Log)
 	strs := req.GetStrings()
+	_ = grpc.SetHeader(ctx, metadata.Pairs(MDAlgorithm, s.algorithm().Name()))
 
 	log.WithField("count", len(strs)).Info("hash fan-in start")
 
-	hashes, err := hasher.HashStringsParallel(ctx, strs)
-
+	hashes, err := s.hash(ctx, strs)
+	if errors.Is(err, hasher.ErrOverloaded) {
+		prio := PriorityFromContext(ctx)
+		s.PoolMetrics.reject(prio)
+		log.WithField("priority", prio.String()).Warn("hash fan-in rejected: queue is full")
+		return nil, status.Error(codes.ResourceExhausted, "hashing queue is full, retry later")
+	}
 	if err != nil {
 		werr := errors.WithStack(err)
 		log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).Error("hash fan-in failed")
@@ -43,3 +75,84 @@ func (s *Server) CalculateHashes(reqCtx context.Context, req *hasherpb.HashReque
 	log.WithField("count", len(hashes)).Info("hash fan-in done")
 	return &hasherpb.HashResponse{Hashes: hashes}, nil
 }
+
+// hash hashes strs, taking what it can from the cache and caching the rest.
+func (s *Server) hash(ctx context.Context, strs []string) ([]string, error) {
+	if s.Cache == nil {
+		return s.compute(ctx, strs)
+	}
+	alg := s.algorithm().Name()
+	out, miss := s.Cache.Get(alg, strs)
+	s.CacheMetrics.lookup(len(strs)-len(miss), len(miss))
+	if len(miss) == 0 {
+		return out, nil
+	}
+	todo := make([]string, len(miss))
+	for j, i := range miss {
+		todo[j] = strs[i]
+	}
+	hashes, err := s.compute(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+	s.Cache.Put(alg, todo, hashes)
+	for j, i := range miss {
+		out[i] = hashes[j]
+	}
+	return out, nil
+}
+
+func (s *Server) compute(ctx context.Context, strs []string) ([]string, error) {
+	if s.Pool == nil {
+		return hasher.HashStrings(ctx, s.algorithm(), strs)
+	}
+	return s.Pool.Hash(ctx, s.algorithm(), PriorityFromContext(ctx), strs)
+}
+
+// CalculateHashesStream hashes binary items sent as a stream of chunks. Every
+// chunk is appended to the current item; a chunk with Last set finishes it.
+// Hashes are returned in the order the items were sent.
+//
+// Streams bypass the pool and are not shed under load: an item is hashed as
+// its chunks arrive, on the goroutine of the stream, so a stream takes at
+// most one CPU at the pace of its sender. Give streaming only to clients
+// that need it (Client.Methods).
+func (s *Server) CalculateHashesStream(stream hasherpb.HasherService_CalculateHashesStreamServer) error {
+	ctx := stream.Context()
+	log := GetLoggerFromCtx(ctx, s.Log)
+
+	log.Info("hash stream start")
+
+	var hashes []string
+	h := s.algorithm().New()
+	open := false
+	for {
+		if s.ShutdownCtx != nil && s.ShutdownCtx.Err() != nil {
+			return s.ShutdownCtx.Err()
+		}
+
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			werr := errors.WithStack(err)
+			log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).Error("hash stream recv failed")
+			return err
+		}
+
+		h.Write(chunk.GetData())
+		open = true
+		if chunk.GetLast() {
+			hashes = append(hashes, hasher.Sum(h))
+			h.Reset()
+			open = false
+		}
+	}
+	if open {
+		return status.Error(codes.InvalidArgument, "stream closed in the middle of an item")
+	}
+
+	log.WithField("count", len(hashes)).Info("hash stream done")
+	return stream.SendAndClose(&hasherpb.HashResponse{Hashes: hashes})
+}
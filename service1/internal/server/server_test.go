@@ -3,60 +3,67 @@ package server_test
 import (
 	"context"
 	"github.com/sirupsen/logrus"
+	"io"
 	"net"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	"service1/internal/server"
+	"service1/pkg/hasher"
 	"service1/proto/hasherpb"
 )
 
 const bufSize = 1024 * 1024
 
-func startBufGRPC(t *testing.T) (*grpc.ClientConn, func()) {
+// startBufGRPC serves over bufconn with opts the services that register
+// adds, or a plain Server if register is nil, and returns a connection to
+// them. Both are closed when the test ends.
+func startBufGRPC(t *testing.T, register func(*grpc.Server), opts ...grpc.ServerOption) *grpc.ClientConn {
 	t.Helper()
 
-	logger := logrus.New()
-
 	lis := bufconn.Listen(bufSize)
-	s := grpc.NewServer()
-	srv := &server.Server{Log: logger}
-	hasherpb.RegisterHasherServiceServer(s, srv)
-
+	s := grpc.NewServer(opts...)
+	if register == nil {
+		register = func(s *grpc.Server) {
+			hasherpb.RegisterHasherServiceServer(s, &server.Server{Log: logrus.New()})
+		}
+	}
+	register(s)
 	go func() { _ = s.Serve(lis) }()
 
-	dialer := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
-	conn, err := grpc.DialContext(context.Background(), "bufnet",
-		grpc.WithContextDialer(dialer),
-		grpc.WithInsecure(),
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	require.NoError(t, err)
-
-	cleanup := func() {
-		s.GracefulStop()
-		_ = lis.Close()
+	t.Cleanup(func() {
 		_ = conn.Close()
-	}
-	return conn, cleanup
+		s.Stop()
+	})
+	return conn
 }
 
 func TestCalculateHashes_Basic(t *testing.T) {
-	conn, cleanup := startBufGRPC(t)
-	defer cleanup()
-
-	client := hasherpb.NewHasherServiceClient(conn)
+	client := hasherpb.NewHasherServiceClient(startBufGRPC(t, nil))
 
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 
 	in := []string{"a", "b", "a", "world"}
-	resp, err := client.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: in})
+	var hdr metadata.MD
+	resp, err := client.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: in}, grpc.Header(&hdr))
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+	require.Equal(t, []string{"sha3-256"}, hdr.Get(server.MDAlgorithm))
 
 	hashes := resp.GetHashes()
 	require.Len(t, hashes, len(in))
@@ -65,10 +72,7 @@ func TestCalculateHashes_Basic(t *testing.T) {
 }
 
 func TestCalculateHashes_Empty(t *testing.T) {
-	conn, cleanup := startBufGRPC(t)
-	defer cleanup()
-
-	client := hasherpb.NewHasherServiceClient(conn)
+	client := hasherpb.NewHasherServiceClient(startBufGRPC(t, nil))
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 
@@ -77,3 +81,57 @@ func TestCalculateHashes_Empty(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Len(t, resp.GetHashes(), 0)
 }
+
+func TestCalculateHashesStream_MatchesStrings(t *testing.T) {
+	client := hasherpb.NewHasherServiceClient(startBufGRPC(t, nil))
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	stream, err := client.CalculateHashesStream(ctx)
+	require.NoError(t, err)
+	// "hello" split across two chunks, then an empty item
+	require.NoError(t, stream.Send(&hasherpb.HashChunk{Data: []byte("hel")}))
+	require.NoError(t, stream.Send(&hasherpb.HashChunk{Data: []byte("lo"), Last: true}))
+	require.NoError(t, stream.Send(&hasherpb.HashChunk{Last: true}))
+	streamed, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	resp, err := client.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"hello", ""}})
+	require.NoError(t, err)
+	require.Equal(t, resp.GetHashes(), streamed.GetHashes())
+}
+
+func TestCalculateHashesStream_UnfinishedItem(t *testing.T) {
+	client := hasherpb.NewHasherServiceClient(startBufGRPC(t, nil))
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	stream, err := client.CalculateHashesStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&hasherpb.HashChunk{Data: []byte("partial")}))
+	_, err = stream.CloseAndRecv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// BenchmarkCalculateHashes measures a call to the server through the pool, as
+// in production, without gRPC; pkg/hasher benchmarks hashing alone.
+func BenchmarkCalculateHashes(b *testing.B) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	pool := hasher.NewPool(0, 1<<20)
+	defer pool.Close()
+	srv := &server.Server{Log: log, Pool: pool, Algorithm: hasher.SHA3_256}
+	ctx := context.Background()
+	for _, n := range []int{1, 10, 100, 10000} {
+		req := &hasherpb.HashRequest{Strings: make([]string, n)}
+		for i := range req.Strings {
+			req.Strings[i] = "payload-" + strconv.Itoa(i)
+		}
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_, _ = srv.CalculateHashes(ctx, req)
+			}
+		})
+	}
+}
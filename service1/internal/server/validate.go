@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"service1/proto/hasherpb"
+)
+
+// Limits bound the size of a request. Zero fields are not enforced.
+type Limits struct {
+	// MaxItems limits the number of strings in a call, or of items in a
+	// stream.
+	MaxItems int
+	// MaxItemBytes limits the length of one string in CalculateHashes. Stream
+	// items are hashed chunk by chunk and may be of any size.
+	MaxItemBytes int
+}
+
+// UnaryValidate rejects a CalculateHashes call over the limits with
+// InvalidArgument before it reaches the handler.
+func UnaryValidate(l Limits) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if r, ok := req.(*hasherpb.HashRequest); ok {
+			if err := l.check(r.GetStrings()); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidate is the streaming counterpart of UnaryValidate: the stream
+// fails with InvalidArgument once the item over MaxItems is received.
+func StreamValidate(l Limits) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if l.MaxItems <= 0 {
+			return handler(srv, ss)
+		}
+		return handler(srv, &validatingStream{WrappedServerStream: middleware.WrapServerStream(ss), limits: l})
+	}
+}
+
+func (l Limits) check(in []string) error {
+	if l.MaxItems > 0 && len(in) > l.MaxItems {
+		return status.Errorf(codes.InvalidArgument, "request has %d strings, at most %d are allowed", len(in), l.MaxItems)
+	}
+	if l.MaxItemBytes > 0 {
+		for i, s := range in {
+			if len(s) > l.MaxItemBytes {
+				return status.Errorf(codes.InvalidArgument, "string %d is longer than %d bytes", i, l.MaxItemBytes)
+			}
+		}
+	}
+	return nil
+}
+
+type validatingStream struct {
+	*middleware.WrappedServerStream
+	limits Limits
+	items  int
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if chunk, ok := m.(*hasherpb.HashChunk); ok && chunk.GetLast() {
+		s.items++
+		if s.items > s.limits.MaxItems {
+			return status.Errorf(codes.InvalidArgument, "stream has more than %d items", s.limits.MaxItems)
+		}
+	}
+	return nil
+}
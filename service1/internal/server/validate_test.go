@@ -0,0 +1,61 @@
+package server_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"service1/internal/server"
+	"service1/proto/hasherpb"
+)
+
+func startValidateGRPC(t *testing.T, l server.Limits) hasherpb.HasherServiceClient {
+	t.Helper()
+	return hasherpb.NewHasherServiceClient(startBufGRPC(t, nil,
+		grpc.UnaryInterceptor(server.UnaryValidate(l)),
+		grpc.StreamInterceptor(server.StreamValidate(l)),
+	))
+}
+
+func TestValidate_Unary(t *testing.T) {
+	client := startValidateGRPC(t, server.Limits{MaxItems: 2, MaxItemBytes: 4})
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_, err := client.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"a", "abcd"}})
+	require.NoError(t, err)
+
+	_, err = client.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"a", "b", "c"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"abcde"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestValidate_Stream(t *testing.T) {
+	client := startValidateGRPC(t, server.Limits{MaxItems: 2, MaxItemBytes: 4})
+
+	send := func(items int) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		st, err := client.CalculateHashesStream(ctx)
+		require.NoError(t, err)
+		for i := 0; i < items; i++ {
+			// длина элемента потока не ограничена
+			if err := st.Send(&hasherpb.HashChunk{Data: []byte(strings.Repeat("x", 100)), Last: true}); err != nil {
+				break
+			}
+		}
+		_, err = st.CloseAndRecv()
+		return err
+	}
+
+	require.NoError(t, send(2))
+	require.Equal(t, codes.InvalidArgument, status.Code(send(3)))
+}
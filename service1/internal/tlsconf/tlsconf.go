@@ -0,0 +1,138 @@
+// Package tlsconf builds the gRPC server TLS config from PEM files and
+// reloads it when the files change, so that rotated certificates are picked
+// up without a restart.
+package tlsconf
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Files are the PEM files of the server. CA, if set, holds the CAs that
+// client certificates must be signed by and turns on mutual TLS.
+type Files struct {
+	Cert string
+	Key  string
+	CA   string
+}
+
+// Reloader holds the current certificate and client CA pool.
+type Reloader struct {
+	files Files
+	log   *logrus.Logger
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	version string
+}
+
+// NewReloader loads files once and fails if they are not a valid key pair.
+func NewReloader(files Files, log *logrus.Logger) (*Reloader, error) {
+	r := &Reloader{files: files, log: log}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Watch checks the files every interval and reloads them when their size or
+// modification time changes. A failed reload is logged and the previous
+// certificate stays in use. Watch returns when ctx is done.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		v, err := r.stat()
+		r.mu.RLock()
+		changed := err == nil && v != r.version
+		r.mu.RUnlock()
+		if !changed {
+			continue
+		}
+		if err := r.reload(); err != nil {
+			r.log.WithError(err).Error("tls: reload failed, keeping previous certificate")
+			continue
+		}
+		r.log.WithField("cert", r.files.Cert).Info("tls: certificate reloaded")
+	}
+}
+
+// ServerConfig returns a config that always serves the current certificate
+// and, with a CA configured, requires and verifies client certificates.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   []string{"h2"},
+			}
+			if r.pool != nil {
+				cfg.ClientCAs = r.pool
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}
+}
+
+func (r *Reloader) reload() error {
+	// версию снимаем до чтения: если файл поменяется во время чтения,
+	// следующая проверка перечитает его ещё раз
+	v, err := r.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.files.Cert, r.files.Key)
+	if err != nil {
+		return fmt.Errorf("load key pair: %w", err)
+	}
+	var pool *x509.CertPool
+	if r.files.CA != "" {
+		pem, err := os.ReadFile(r.files.CA)
+		if err != nil {
+			return fmt.Errorf("read ca: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in %s", r.files.CA)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.pool, r.version = &cert, pool, v
+	r.mu.Unlock()
+	return nil
+}
+
+// stat returns a string that changes whenever any of the files changes.
+func (r *Reloader) stat() (string, error) {
+	var v string
+	for _, name := range []string{r.files.Cert, r.files.Key, r.files.CA} {
+		if name == "" {
+			continue
+		}
+		fi, err := os.Stat(name)
+		if err != nil {
+			return "", err
+		}
+		v += fmt.Sprintf("%s:%d:%d;", name, fi.Size(), fi.ModTime().UnixNano())
+	}
+	return v, nil
+}
@@ -0,0 +1,197 @@
+package tlsconf_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"service1/internal/server"
+	"service1/internal/tlsconf"
+	"service1/proto/hasherpb"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key signed by ca for the given SANs.
+func (ca *testCA) issue(t *testing.T, serial int64, dns string, uri string, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: dns},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{dns},
+	}
+	if uri != "" {
+		u, err := url.Parse(uri)
+		require.NoError(t, err)
+		tmpl.URIs = []*url.URL{u}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func startTLSServer(t *testing.T, files tlsconf.Files, allowed []string) string {
+	t.Helper()
+	certs, err := tlsconf.NewReloader(files, logrus.New())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go certs.Watch(ctx, 10*time.Millisecond)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	opts := []grpc.ServerOption{grpc.Creds(credentials.NewTLS(certs.ServerConfig()))}
+	if len(allowed) > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(server.UnaryAuthorizeSAN(allowed)))
+	}
+	s := grpc.NewServer(opts...)
+	hasherpb.RegisterHasherServiceServer(s, &server.Server{Log: logrus.New()})
+	go func() { _ = s.Serve(lis) }()
+
+	t.Cleanup(func() {
+		cancel()
+		s.Stop()
+	})
+	return lis.Addr().String()
+}
+
+func call(t *testing.T, addr string, roots *x509.CertPool, certPEM, keyPEM []byte) error {
+	t.Helper()
+	cfg := &tls.Config{RootCAs: roots, ServerName: "service1"}
+	if certPEM != nil {
+		c, err := tls.X509KeyPair(certPEM, keyPEM)
+		require.NoError(t, err)
+		cfg.Certificates = []tls.Certificate{c}
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = hasherpb.NewHasherServiceClient(conn).CalculateHashes(ctx, &hasherpb.HashRequest{Strings: []string{"a"}})
+	return err
+}
+
+func TestMutualTLS_AuthorizesBySAN(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCA(t)
+	files := tlsconf.Files{
+		Cert: filepath.Join(dir, "server.pem"),
+		Key:  filepath.Join(dir, "server-key.pem"),
+		CA:   filepath.Join(dir, "ca.pem"),
+	}
+	cert, key := ca.issue(t, 2, "service1", "", x509.ExtKeyUsageServerAuth)
+	writeFile(t, files.Cert, cert)
+	writeFile(t, files.Key, key)
+	writeFile(t, files.CA, ca.pem)
+
+	addr := startTLSServer(t, files, []string{"spiffe://cluster/service2"})
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	cert, key = ca.issue(t, 3, "service2", "spiffe://cluster/service2", x509.ExtKeyUsageClientAuth)
+	require.NoError(t, call(t, addr, roots, cert, key))
+
+	cert, key = ca.issue(t, 4, "intruder", "spiffe://cluster/intruder", x509.ExtKeyUsageClientAuth)
+	require.Equal(t, codes.PermissionDenied, status.Code(call(t, addr, roots, cert, key)))
+
+	// без клиентского сертификата рукопожатие не проходит
+	require.Error(t, call(t, addr, roots, nil, nil))
+
+	// сертификат от чужого CA отклоняется, даже если SAN разрешён
+	cert, key = newCA(t).issue(t, 5, "service2", "spiffe://cluster/service2", x509.ExtKeyUsageClientAuth)
+	require.Error(t, call(t, addr, roots, cert, key))
+}
+
+func TestReloader_PicksUpRotatedCert(t *testing.T) {
+	dir := t.TempDir()
+	oldCA, rotatedCA := newCA(t), newCA(t)
+	files := tlsconf.Files{Cert: filepath.Join(dir, "server.pem"), Key: filepath.Join(dir, "server-key.pem")}
+	cert, key := oldCA.issue(t, 2, "service1", "", x509.ExtKeyUsageServerAuth)
+	writeFile(t, files.Cert, cert)
+	writeFile(t, files.Key, key)
+
+	addr := startTLSServer(t, files, nil)
+	oldRoots, newRoots := x509.NewCertPool(), x509.NewCertPool()
+	oldRoots.AddCert(oldCA.cert)
+	newRoots.AddCert(rotatedCA.cert)
+
+	require.NoError(t, call(t, addr, oldRoots, nil, nil))
+
+	cert, key = rotatedCA.issue(t, 3, "service1", "", x509.ExtKeyUsageServerAuth)
+	writeFile(t, files.Key, key)
+	writeFile(t, files.Cert, cert)
+
+	require.Eventually(t, func() bool {
+		return call(t, addr, newRoots, nil, nil) == nil
+	}, 2*time.Second, 20*time.Millisecond)
+	require.Equal(t, codes.Unavailable, status.Code(call(t, addr, oldRoots, nil, nil)))
+}
+
+func TestNewReloader_InvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := tlsconf.Files{Cert: filepath.Join(dir, "server.pem"), Key: filepath.Join(dir, "server-key.pem")}
+	writeFile(t, files.Cert, []byte("not a cert"))
+	writeFile(t, files.Key, []byte("not a key"))
+
+	_, err := tlsconf.NewReloader(files, logrus.New())
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package hasher
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"sync"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Algorithm is a hash function over strings. Sum may hash several strings at
+// once with SIMD; it gives the same digests as hashing them one by one
+// through New.
+type Algorithm interface {
+	// Name is the name of the algorithm in config, e.g. "sha3-256".
+	Name() string
+	// Size is the digest size in bytes.
+	Size() int
+	// New returns a hash.Hash for incremental hashing of one item.
+	New() hash.Hash
+	// Sum writes the digests of in back to back to dst, which must have room
+	// for len(in)*Size() bytes.
+	Sum(dst []byte, in []string)
+	// Backend names the implementation chosen for this CPU, e.g. "avx512".
+	Backend() string
+}
+
+// Поддерживаемые алгоритмы. Реализация выбирается при старте по возможностям CPU.
+var (
+	SHA3_256 Algorithm = &algorithm{name: "sha3-256", new: sha3.New256}
+	SHA256   Algorithm = &algorithm{name: "sha256", new: sha256.New}
+)
+
+// Algorithms lists the supported algorithms.
+func Algorithms() []Algorithm {
+	return []Algorithm{SHA3_256, SHA256}
+}
+
+// Lookup returns the algorithm called name.
+func Lookup(name string) (Algorithm, error) {
+	for _, a := range Algorithms() {
+		if a.Name() == name {
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("hasher: unknown algorithm %q", name)
+}
+
+// init picks the fastest multi-buffer kernels the CPU supports, as listed by
+// sha256Backends and keccakBackends of the architecture's sum_*.go.
+func init() {
+	if len(sha256Backends) > 0 {
+		SHA256.(*algorithm).mb = sha256Backends[0]
+	}
+	if len(keccakBackends) > 0 {
+		SHA3_256.(*algorithm).mb = keccakBackends[0]
+	}
+}
+
+// multiBuffer hashes strings several at a time. sum leaves strings it does
+// not take (too long, or too few to fill the lanes) to one.
+type multiBuffer interface {
+	name() string
+	sum(dst []byte, in []string, one *oneState)
+}
+
+type algorithm struct {
+	name string
+	new  func() hash.Hash
+	mb   multiBuffer // nil — только по одной строке
+	pool sync.Pool
+}
+
+func (a *algorithm) Name() string { return a.name }
+
+func (a *algorithm) Size() int { return 32 }
+
+func (a *algorithm) New() hash.Hash { return a.new() }
+
+func (a *algorithm) Backend() string {
+	if a.mb != nil {
+		return a.mb.name()
+	}
+	return "generic"
+}
+
+// oneState hashes one string at a time without allocating.
+type oneState struct {
+	h    hash.Hash
+	data []byte
+}
+
+func (st *oneState) sum(dst []byte, s string) {
+	st.h.Reset()
+	st.data = append(st.data[:0], s...)
+	st.h.Write(st.data)
+	st.h.Sum(dst[:0])
+}
+
+func (a *algorithm) Sum(dst []byte, in []string) {
+	st, _ := a.pool.Get().(*oneState)
+	if st == nil {
+		st = &oneState{h: a.new()}
+	}
+	defer a.pool.Put(st)
+
+	if a.mb != nil {
+		a.mb.sum(dst, in, st)
+		return
+	}
+	for i, s := range in {
+		st.sum(dst[i*32:], s)
+	}
+}
@@ -0,0 +1,372 @@
+//go:build ignore
+
+// asm_gen generates sum_amd64.s, the multi-buffer SHA-256 and Keccak kernels.
+// The code is fully unrolled, so it is written by a program rather than by
+// hand: go generate ./pkg/hasher
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+)
+
+var out bytes.Buffer
+
+func emit(format string, args ...any) {
+	fmt.Fprintf(&out, "\t"+format+"\n", args...)
+}
+
+func comment(format string, args ...any) {
+	fmt.Fprintf(&out, "\n\t// "+format+"\n", args...)
+}
+
+func main() {
+	out.WriteString("// Code generated by asm_gen.go. DO NOT EDIT.\n\n")
+	out.WriteString("//go:build amd64 && !purego\n\n")
+	out.WriteString("#include \"textflag.h\"\n")
+
+	sha256Consts()
+	keccakConsts()
+	sha256AVX2()
+	sha256AVX512()
+	keccakAVX2()
+	keccakAVX512()
+
+	if err := os.WriteFile("sum_amd64.s", out.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+var sha256K = [64]uint32{
+	0x428a2f98, 0x71374491, 0xb5c0fbcf, 0xe9b5dba5, 0x3956c25b, 0x59f111f1, 0x923f82a4, 0xab1c5ed5,
+	0xd807aa98, 0x12835b01, 0x243185be, 0x550c7dc3, 0x72be5d74, 0x80deb1fe, 0x9bdc06a7, 0xc19bf174,
+	0xe49b69c1, 0xefbe4786, 0x0fc19dc6, 0x240ca1cc, 0x2de92c6f, 0x4a7484aa, 0x5cb0a9dc, 0x76f988da,
+	0x983e5152, 0xa831c66d, 0xb00327c8, 0xbf597fc7, 0xc6e00bf3, 0xd5a79147, 0x06ca6351, 0x14292967,
+	0x27b70a85, 0x2e1b2138, 0x4d2c6dfc, 0x53380d13, 0x650a7354, 0x766a0abb, 0x81c2c92e, 0x92722c85,
+	0xa2bfe8a1, 0xa81a664b, 0xc24b8b70, 0xc76c51a3, 0xd192e819, 0xd6990624, 0xf40e3585, 0x106aa070,
+	0x19a4c116, 0x1e376c08, 0x2748774c, 0x34b0bcb5, 0x391c0cb3, 0x4ed8aa4a, 0x5b9cca4f, 0x682e6ff3,
+	0x748f82ee, 0x78a5636f, 0x84c87814, 0x8cc70208, 0x90befffa, 0xa4506ceb, 0xbef9a3f7, 0xc67178f2,
+}
+
+var keccakRC = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// keccakRot[x+5y] is the ρ rotation of lane (x, y).
+var keccakRot = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// keccakPi returns the lane that π moves lane w = x+5y to.
+func keccakPi(w int) int {
+	x, y := w%5, w/5
+	return y + 5*((2*x+3*y)%5)
+}
+
+func sha256Consts() {
+	out.WriteString("\n")
+	for i, k := range sha256K {
+		fmt.Fprintf(&out, "DATA sha256K<>+%d(SB)/4, $0x%08x\n", i*4, k)
+	}
+	out.WriteString("GLOBL sha256K<>(SB), RODATA|NOPTR, $256\n")
+}
+
+func keccakConsts() {
+	out.WriteString("\n")
+	for i, rc := range keccakRC {
+		fmt.Fprintf(&out, "DATA keccakRC<>+%d(SB)/8, $0x%016x\n", i*8, rc)
+	}
+	out.WriteString("GLOBL keccakRC<>(SB), RODATA|NOPTR, $192\n")
+}
+
+// sha256AVX2 compresses one block of 8 messages. Each ymm register holds one
+// state or message word of all 8 lanes; AVX2 has no rotate, so every rotation
+// is two shifts and an OR. The message schedule lives on the stack.
+func sha256AVX2() {
+	out.WriteString("\n// func sha256BlockAVX2(state *[8 * 8]uint32, msg *[16 * 8]uint32)\n")
+	out.WriteString("TEXT ·sha256BlockAVX2(SB), 0, $2048-16\n")
+	emit("MOVQ state+0(FP), AX")
+	emit("MOVQ msg+8(FP), SI")
+	emit("LEAQ sha256K<>(SB), BX")
+
+	comment("W[0..15] = msg")
+	for i := 0; i < 16; i++ {
+		emit("VMOVDQU %d(SI), Y8", i*32)
+		emit("VMOVDQU Y8, %d(SP)", i*32)
+	}
+
+	// rotr sets dst = dst_acc ^ (src >>> n); the first term initialises dst
+	rotr := func(src string, n int, dst string, first bool) {
+		emit("VPSRLD $%d, %s, Y13", n, src)
+		emit("VPSLLD $%d, %s, Y14", 32-n, src)
+		if first {
+			emit("VPOR Y13, Y14, %s", dst)
+			return
+		}
+		emit("VPXOR Y13, %s, %s", dst, dst)
+		emit("VPXOR Y14, %s, %s", dst, dst)
+	}
+
+	comment("W[16..63]")
+	for t := 16; t < 64; t++ {
+		emit("VMOVDQU %d(SP), Y8", (t-2)*32)
+		rotr("Y8", 17, "Y9", true)
+		rotr("Y8", 19, "Y9", false)
+		emit("VPSRLD $10, Y8, Y13")
+		emit("VPXOR Y13, Y9, Y9")
+		emit("VMOVDQU %d(SP), Y8", (t-15)*32)
+		rotr("Y8", 7, "Y10", true)
+		rotr("Y8", 18, "Y10", false)
+		emit("VPSRLD $3, Y8, Y13")
+		emit("VPXOR Y13, Y10, Y10")
+		emit("VPADDD Y9, Y10, Y10")
+		emit("VPADDD %d(SP), Y10, Y10", (t-7)*32)
+		emit("VPADDD %d(SP), Y10, Y10", (t-16)*32)
+		emit("VMOVDQU Y10, %d(SP)", t*32)
+	}
+
+	comment("a..h")
+	for i := 0; i < 8; i++ {
+		emit("VMOVDQU %d(AX), Y%d", i*32, i)
+	}
+
+	r := []string{"Y0", "Y1", "Y2", "Y3", "Y4", "Y5", "Y6", "Y7"}
+	for t := 0; t < 64; t++ {
+		a, b, c, d, e, f, g, h := r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]
+		comment("round %d", t)
+		rotr(e, 6, "Y10", true)
+		rotr(e, 11, "Y10", false)
+		rotr(e, 25, "Y10", false)
+		emit("VPADDD Y10, %s, %s", h, h)
+		emit("VPAND %s, %s, Y8", f, e)
+		emit("VPANDN %s, %s, Y9", g, e)
+		emit("VPXOR Y8, Y9, Y8")
+		emit("VPADDD Y8, %s, %s", h, h)
+		emit("VPBROADCASTD %d(BX), Y8", t*4)
+		emit("VPADDD Y8, %s, %s", h, h)
+		emit("VPADDD %d(SP), %s, %s", t*32, h, h)
+		emit("VPADDD %s, %s, %s", h, d, d)
+		rotr(a, 2, "Y10", true)
+		rotr(a, 13, "Y10", false)
+		rotr(a, 22, "Y10", false)
+		emit("VPADDD Y10, %s, %s", h, h)
+		emit("VPOR %s, %s, Y8", a, b)
+		emit("VPAND %s, Y8, Y8", c)
+		emit("VPAND %s, %s, Y9", a, b)
+		emit("VPOR Y9, Y8, Y8")
+		emit("VPADDD Y8, %s, %s", h, h)
+		// h now holds the new a, d the new e
+		r = append([]string{h}, r[:7]...)
+	}
+
+	comment("state += a..h")
+	for i := 0; i < 8; i++ {
+		emit("VPADDD %d(AX), %s, %s", i*32, r[i], r[i])
+		emit("VMOVDQU %s, %d(AX)", r[i], i*32)
+	}
+	emit("VZEROUPPER")
+	emit("RET")
+}
+
+// sha256AVX512 compresses one block of 16 messages. AVX-512 has rotates and
+// three-input logic, and its 32 registers hold the state, the 16-word
+// message schedule window and the temporaries.
+func sha256AVX512() {
+	out.WriteString("\n// func sha256BlockAVX512(state *[8 * 16]uint32, msg *[16 * 16]uint32)\n")
+	out.WriteString("TEXT ·sha256BlockAVX512(SB), NOSPLIT, $0-16\n")
+	emit("MOVQ state+0(FP), AX")
+	emit("MOVQ msg+8(FP), SI")
+	emit("LEAQ sha256K<>(SB), BX")
+	for i := 0; i < 8; i++ {
+		emit("VMOVDQU32 %d(AX), Z%d", i*64, i)
+	}
+	for i := 0; i < 16; i++ {
+		emit("VMOVDQU32 %d(SI), Z%d", i*64, 8+i)
+	}
+	w := func(t int) string { return fmt.Sprintf("Z%d", 8+t%16) }
+
+	r := []string{"Z0", "Z1", "Z2", "Z3", "Z4", "Z5", "Z6", "Z7"}
+	for t := 0; t < 64; t++ {
+		a, b, c, d, e, f, g, h := r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]
+		comment("round %d", t)
+		if t >= 16 {
+			emit("VPRORD $17, %s, Z24", w(t-2))
+			emit("VPRORD $19, %s, Z25", w(t-2))
+			emit("VPSRLD $10, %s, Z26", w(t-2))
+			emit("VPTERNLOGD $0x96, Z26, Z25, Z24")
+			emit("VPRORD $7, %s, Z25", w(t-15))
+			emit("VPRORD $18, %s, Z26", w(t-15))
+			emit("VPSRLD $3, %s, Z27", w(t-15))
+			emit("VPTERNLOGD $0x96, Z27, Z26, Z25")
+			emit("VPADDD Z24, %s, %s", w(t), w(t))
+			emit("VPADDD Z25, %s, %s", w(t), w(t))
+			emit("VPADDD %s, %s, %s", w(t-7), w(t), w(t))
+		}
+		emit("VPRORD $6, %s, Z24", e)
+		emit("VPRORD $11, %s, Z25", e)
+		emit("VPRORD $25, %s, Z26", e)
+		emit("VPTERNLOGD $0x96, Z26, Z25, Z24")
+		emit("VPADDD Z24, %s, %s", h, h)
+		emit("VMOVDQA64 %s, Z25", e)
+		emit("VPTERNLOGD $0xca, %s, %s, Z25", g, f)
+		emit("VPADDD Z25, %s, %s", h, h)
+		emit("VPBROADCASTD %d(BX), Z26", t*4)
+		emit("VPADDD Z26, %s, %s", h, h)
+		emit("VPADDD %s, %s, %s", w(t), h, h)
+		emit("VPADDD %s, %s, %s", h, d, d)
+		emit("VPRORD $2, %s, Z24", a)
+		emit("VPRORD $13, %s, Z25", a)
+		emit("VPRORD $22, %s, Z26", a)
+		emit("VPTERNLOGD $0x96, Z26, Z25, Z24")
+		emit("VPADDD Z24, %s, %s", h, h)
+		emit("VMOVDQA64 %s, Z25", a)
+		emit("VPTERNLOGD $0xe8, %s, %s, Z25", c, b)
+		emit("VPADDD Z25, %s, %s", h, h)
+		r = append([]string{h}, r[:7]...)
+	}
+
+	comment("state += a..h")
+	for i := 0; i < 8; i++ {
+		emit("VPADDD %d(AX), %s, %s", i*64, r[i], r[i])
+		emit("VMOVDQU32 %s, %d(AX)", r[i], i*64)
+	}
+	emit("VZEROUPPER")
+	emit("RET")
+}
+
+// keccakAVX2 applies Keccak-f[1600] to 4 states. Each ymm register holds one
+// lane of all 4 states. 25 lanes do not fit into 16 registers, so the state
+// stays in memory and the loop runs over the 24 rounds.
+func keccakAVX2() {
+	out.WriteString("\n// func keccakF1600AVX2(state *[25 * 4]uint64)\n")
+	out.WriteString("TEXT ·keccakF1600AVX2(SB), 0, $800-8\n")
+	emit("MOVQ state+0(FP), AX")
+	emit("LEAQ keccakRC<>(SB), BX")
+	emit("MOVQ $24, CX")
+	out.WriteString("\nround:\n")
+
+	comment("θ: C[x] in Y0..Y4, D[x] in Y5..Y9")
+	for x := 0; x < 5; x++ {
+		emit("VMOVDQU %d(AX), Y%d", x*32, x)
+		for y := 1; y < 5; y++ {
+			emit("VPXOR %d(AX), Y%d, Y%d", (x+5*y)*32, x, x)
+		}
+	}
+	for x := 0; x < 5; x++ {
+		next, prev := (x+1)%5, (x+4)%5
+		emit("VPSLLQ $1, Y%d, Y%d", next, 5+x)
+		emit("VPSRLQ $63, Y%d, Y10", next)
+		emit("VPOR Y10, Y%d, Y%d", 5+x, 5+x)
+		emit("VPXOR Y%d, Y%d, Y%d", prev, 5+x, 5+x)
+	}
+
+	comment("ρ and π into B on the stack")
+	for lane := 0; lane < 25; lane++ {
+		x := lane % 5
+		emit("VMOVDQU %d(AX), Y10", lane*32)
+		emit("VPXOR Y%d, Y10, Y10", 5+x)
+		if n := keccakRot[lane]; n != 0 {
+			emit("VPSLLQ $%d, Y10, Y11", n)
+			emit("VPSRLQ $%d, Y10, Y10", 64-n)
+			emit("VPOR Y11, Y10, Y10")
+		}
+		emit("VMOVDQU Y10, %d(SP)", keccakPi(lane)*32)
+	}
+
+	comment("χ and ι back into the state")
+	emit("VPBROADCASTQ (BX), Y5")
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			emit("VMOVDQU %d(SP), Y%d", (x+5*y)*32, 10+x)
+		}
+		for x := 0; x < 5; x++ {
+			emit("VPANDN Y%d, Y%d, Y15", 10+(x+2)%5, 10+(x+1)%5)
+			emit("VPXOR Y%d, Y15, Y15", 10+x)
+			if x == 0 && y == 0 {
+				emit("VPXOR Y5, Y15, Y15")
+			}
+			emit("VMOVDQU Y15, %d(AX)", (x+5*y)*32)
+		}
+	}
+
+	emit("ADDQ $8, BX")
+	emit("DECQ CX")
+	emit("JNZ round")
+	emit("VZEROUPPER")
+	emit("RET")
+}
+
+// keccakAVX512 applies Keccak-f[1600] to 8 states. The 25 lanes live in
+// Z0..Z24 for all 24 rounds. π only renames registers, so the generator
+// tracks which register holds which lane instead of moving them.
+func keccakAVX512() {
+	out.WriteString("\n// func keccakF1600AVX512(state *[25 * 8]uint64)\n")
+	out.WriteString("TEXT ·keccakF1600AVX512(SB), NOSPLIT, $0-8\n")
+	emit("MOVQ state+0(FP), AX")
+	emit("LEAQ keccakRC<>(SB), BX")
+	var reg [25]string
+	for i := range reg {
+		reg[i] = fmt.Sprintf("Z%d", i)
+		emit("VMOVDQU64 %d(AX), %s", i*64, reg[i])
+	}
+
+	for round := 0; round < 24; round++ {
+		comment("round %d: θ", round)
+		for x := 0; x < 5; x++ {
+			c := fmt.Sprintf("Z%d", 25+x)
+			emit("VMOVDQA64 %s, %s", reg[x], c)
+			emit("VPTERNLOGQ $0x96, %s, %s, %s", reg[x+10], reg[x+5], c)
+			emit("VPTERNLOGQ $0x96, %s, %s, %s", reg[x+20], reg[x+15], c)
+		}
+		for x := 0; x < 5; x++ {
+			d := fmt.Sprintf("Z%d", 30+x%2)
+			emit("VPROLQ $1, Z%d, %s", 25+(x+1)%5, d)
+			emit("VPXORQ Z%d, %s, %s", 25+(x+4)%5, d, d)
+			for y := 0; y < 5; y++ {
+				emit("VPXORQ %s, %s, %s", d, reg[x+5*y], reg[x+5*y])
+			}
+		}
+
+		comment("round %d: ρ, π", round)
+		var next [25]string
+		for lane := 0; lane < 25; lane++ {
+			if n := keccakRot[lane]; n != 0 {
+				emit("VPROLQ $%d, %s, %s", n, reg[lane], reg[lane])
+			}
+			next[keccakPi(lane)] = reg[lane]
+		}
+		reg = next
+
+		comment("round %d: χ, ι", round)
+		for y := 0; y < 5; y++ {
+			row := reg[5*y : 5*y+5]
+			emit("VMOVDQA64 %s, Z25", row[0])
+			emit("VMOVDQA64 %s, Z26", row[1])
+			emit("VPTERNLOGQ $0xd2, %s, %s, %s", row[2], row[1], row[0])
+			emit("VPTERNLOGQ $0xd2, %s, %s, %s", row[3], row[2], row[1])
+			emit("VPTERNLOGQ $0xd2, %s, %s, %s", row[4], row[3], row[2])
+			emit("VPTERNLOGQ $0xd2, Z25, %s, %s", row[4], row[3])
+			emit("VPTERNLOGQ $0xd2, Z26, Z25, %s", row[4])
+		}
+		emit("VPBROADCASTQ %d(BX), Z27", round*8)
+		emit("VPXORQ Z27, %s, %s", reg[0], reg[0])
+	}
+
+	comment("store")
+	for i := range reg {
+		emit("VMOVDQU64 %s, %d(AX)", reg[i], i*64)
+	}
+	emit("VZEROUPPER")
+	emit("RET")
+}
@@ -2,90 +2,114 @@ package hasher
 
 import (
 	"context"
-	"fmt"
-	"golang.org/x/crypto/sha3"
+	"encoding/hex"
+	"hash"
 	"runtime"
+	"slices"
+	"strings"
 	"sync"
 )
 
-type job struct {
-	index int
-	value string
-}
+const (
+	// seqThreshold — до стольких строк хешируем в вызывающей горутине:
+	// запуск горутин обходится дороже самого хеширования
+	seqThreshold = 64
+	// ctxCheckEvery — как часто воркер проверяет отмену контекста
+	ctxCheckEvery = 256
+)
 
-type result struct {
-	index int
-	hash  string
+// HashStringsParallel returns the hex SHA3-256 of every string of input, in
+// input order, see HashStrings.
+func HashStringsParallel(ctx context.Context, input []string) ([]string, error) {
+	return HashStrings(ctx, SHA3_256, input)
 }
 
-func HashStringsParallel(ctx context.Context, input []string) ([]string, error) {
-	numWorkers := runtime.NumCPU()
-	jobs := make(chan job, len(input))
-	results := make(chan result, len(input))
+// HashStrings returns the hex digest by alg of every string of input, in
+// input order. Small inputs are hashed in the calling goroutine; larger ones
+// are split into one contiguous range per CPU. On cancellation it returns the
+// context error and no hashes.
+func HashStrings(ctx context.Context, alg Algorithm, input []string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	n := len(input)
+	output := make([]string, n)
+	if n <= seqThreshold {
+		hashRange(alg, input, output)
+		return output, nil
+	}
 
-	var wg sync.WaitGroup
+	workers := min(runtime.NumCPU(), (n+minChunk-1)/minChunk)
+	chunk := (n + workers - 1) / workers
 
-	// fan-out
-	for i := 0; i < numWorkers; i++ {
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += chunk {
+		hi := min(lo+chunk, n)
 		wg.Add(1)
-
 		go func() {
 			defer wg.Done()
-			for j := range jobs {
-				select {
-				case <-ctx.Done():
+			for i := lo; i < hi; i += ctxCheckEvery {
+				if ctx.Err() != nil {
 					return
-				default:
-				}
-				sum := sha3.Sum256([]byte(j.value))
-				h := fmt.Sprintf("%x", sum)
-
-				select {
-				case <-ctx.Done():
-					return
-				case results <- result{index: j.index, hash: h}:
 				}
+				end := min(i+ctxCheckEvery, hi)
+				hashRange(alg, input[i:end], output[i:end])
 			}
 		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// NewStream returns a hash.Hash for incremental hashing of binary data. It uses
+// the same algorithm as HashStringsParallel, so hashing the bytes of a string
+// through it gives the same result.
+func NewStream() hash.Hash {
+	return SHA3_256.New()
+}
 
+// Sum finalizes h and returns its digest hex-encoded.
+func Sum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+const hexSize = 2 * 32
+
+// rangeState — буфер дайджестов, переиспользуемый между вызовами hashRange
+type rangeState struct {
+	sum []byte
+}
+
+var rangeStates = sync.Pool{New: func() any { return new(rangeState) }}
+
+// hashRange writes the hex digest by alg of every string of in to out. The
+// digests are encoded back to back into a single string that out slices, so
+// a range allocates only that string however many strings it has.
+func hashRange(alg Algorithm, in, out []string) {
+	if len(in) == 0 {
+		return
 	}
+	st := rangeStates.Get().(*rangeState)
+	defer rangeStates.Put(st)
 
-	// send jobs
-	go func() {
-		for i, s := range input {
-			select {
-			case <-ctx.Done():
-				close(jobs)
-				return
-			case jobs <- job{index: i, value: s}:
-			}
-		}
-		close(jobs)
-	}()
+	size := alg.Size()
+	st.sum = slices.Grow(st.sum[:0], len(in)*size)[:len(in)*size]
+	alg.Sum(st.sum, in)
 
-	// waiting for jobs to complete
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
+	var b strings.Builder
+	b.Grow(len(in) * 2 * size)
+	var enc [hexSize]byte
+	for i := range in {
+		hex.Encode(enc[:], st.sum[i*size:(i+1)*size])
+		b.Write(enc[:2*size])
+	}
 
-	// fan-in
-	output := make([]string, n)
-	received := 0
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case r, ok := <-results:
-			if !ok {
-				return output, nil
-			}
-			output[r.index] = r.hash
-			received++
-			if received == n {
-				return output, nil
-			}
-		}
+	all := b.String()
+	for i := range out {
+		out[i] = all[i*2*size : (i+1)*2*size]
 	}
 }
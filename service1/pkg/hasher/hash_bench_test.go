@@ -0,0 +1,112 @@
+package hasher
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+// hashStringsChannels is the previous implementation of HashStringsParallel,
+// one channel message per string, kept as the baseline for the benchmarks.
+func hashStringsChannels(ctx context.Context, input []string) ([]string, error) {
+	type job struct {
+		index int
+		value string
+	}
+	type result struct {
+		index int
+		hash  string
+	}
+	jobs := make(chan job, len(input))
+	results := make(chan result, len(input))
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				sum := sha3.Sum256([]byte(j.value))
+				results <- result{index: j.index, hash: fmt.Sprintf("%x", sum)}
+			}
+		}()
+	}
+	for i, s := range input {
+		jobs <- job{index: i, value: s}
+	}
+	close(jobs)
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	output := make([]string, len(input))
+	for r := range results {
+		output[r.index] = r.hash
+	}
+	return output, ctx.Err()
+}
+
+func benchInput(n int) []string {
+	in := make([]string, n)
+	for i := range in {
+		in[i] = "payload-" + strconv.Itoa(i)
+	}
+	return in
+}
+
+func BenchmarkHashStrings(b *testing.B) {
+	ctx := context.Background()
+	for _, n := range []int{1, 10, 100, 10000} {
+		in := benchInput(n)
+		b.Run(fmt.Sprintf("adaptive/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_, _ = HashStringsParallel(ctx, in)
+			}
+		})
+		b.Run(fmt.Sprintf("channels/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_, _ = hashStringsChannels(ctx, in)
+			}
+		})
+	}
+}
+
+// BenchmarkPoolHash measures the path of production calls, which always go
+// through the pool.
+func BenchmarkPoolHash(b *testing.B) {
+	ctx := context.Background()
+	p := NewPool(0, 1<<20)
+	defer p.Close()
+	for _, n := range []int{1, 10, 100, 10000} {
+		in := benchInput(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_, _ = p.Hash(ctx, SHA3_256, PriorityNormal, in)
+			}
+		})
+	}
+}
+
+func TestHashStringsParallel_MatchesBaseline(t *testing.T) {
+	p := NewPool(0, 1<<20)
+	defer p.Close()
+	for _, n := range []int{1, seqThreshold, seqThreshold + 1, 5000} {
+		in := benchInput(n)
+		want, err := hashStringsChannels(context.Background(), in)
+		require.NoError(t, err)
+		got, err := HashStringsParallel(context.Background(), in)
+		require.NoError(t, err)
+		require.Equal(t, want, got, "n=%d", n)
+		got, err = p.Hash(context.Background(), SHA3_256, PriorityNormal, in)
+		require.NoError(t, err)
+		require.Equal(t, want, got, "pool, n=%d", n)
+	}
+}
@@ -0,0 +1,194 @@
+package hasher
+
+import (
+	"encoding/binary"
+	"slices"
+	"sync"
+)
+
+// Multi-buffer hashing runs the block function of several messages in the
+// lanes of one SIMD register. The kernels take the state and message words
+// word-major: word i of lane l is at i*lanes+l. The drivers below pad the
+// messages, transpose them into that layout and group messages of equal
+// block count, so that no lane idles while a long neighbour finishes.
+
+// mbMaxLen — строки длиннее хешируются по одной: в группе они держали бы
+// остальные дорожки
+const mbMaxLen = 1024
+
+// mbMaxLanes — больше дорожек не бывает ни у одного ядра
+const mbMaxLanes = 16
+
+// mbScratch — буферы драйверов, переиспользуемые между вызовами
+type mbScratch struct {
+	idx   []int
+	st32  [8 * mbMaxLanes]uint32
+	msg32 [16 * mbMaxLanes]uint32
+	st64  [25 * mbMaxLanes]uint64
+	block [keccakRate]byte
+}
+
+var mbScratches = sync.Pool{New: func() any { return new(mbScratch) }}
+
+// groups calls hash for runs of up to lanes indexes of in, shortest strings
+// first, and hashes through one what it does not take: strings over
+// mbMaxLen and a last run too short to be worth the lanes.
+func groups(sc *mbScratch, dst []byte, in []string, lanes, blockSize int, one *oneState, hash func(idx []int)) {
+	sc.idx = sc.idx[:0]
+	for i, s := range in {
+		if len(s) > mbMaxLen {
+			one.sum(dst[i*32:], s)
+			continue
+		}
+		sc.idx = append(sc.idx, i)
+	}
+	slices.SortFunc(sc.idx, func(a, b int) int { return len(in[a])/blockSize - len(in[b])/blockSize })
+
+	idx := sc.idx
+	for len(idx) >= lanes/2 {
+		n := min(lanes, len(idx))
+		hash(idx[:n])
+		idx = idx[n:]
+	}
+	for _, i := range idx {
+		one.sum(dst[i*32:], in[i])
+	}
+}
+
+// sha256MB is SHA-256 on lanes messages at a time; block compresses one
+// 64-byte block of each lane.
+type sha256MB struct {
+	backend string
+	lanes   int
+	block   func(state *uint32, msg *uint32)
+}
+
+var sha256IV = [8]uint32{0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a, 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19}
+
+func (m *sha256MB) name() string { return m.backend }
+
+func (m *sha256MB) sum(dst []byte, in []string, one *oneState) {
+	sc := mbScratches.Get().(*mbScratch)
+	defer mbScratches.Put(sc)
+	groups(sc, dst, in, m.lanes, 64, one, func(idx []int) { m.group(sc, dst, in, idx) })
+}
+
+func (m *sha256MB) group(sc *mbScratch, dst []byte, in []string, idx []int) {
+	L := m.lanes
+	for w, v := range sha256IV {
+		for l := 0; l < L; l++ {
+			sc.st32[w*L+l] = v
+		}
+	}
+	var blocks [mbMaxLanes]int
+	last := 0
+	for l, i := range idx {
+		blocks[l] = (len(in[i]) + 9 + 63) / 64
+		last = max(last, blocks[l])
+	}
+
+	for j := 0; j < last; j++ {
+		for l, i := range idx {
+			if j >= blocks[l] {
+				continue
+			}
+			b := sc.block[:64]
+			sha256Pad(b, in[i], j, blocks[l])
+			for w := 0; w < 16; w++ {
+				sc.msg32[w*L+l] = binary.BigEndian.Uint32(b[w*4:])
+			}
+		}
+		m.block(&sc.st32[0], &sc.msg32[0])
+		for l, i := range idx {
+			if j != blocks[l]-1 {
+				continue
+			}
+			d := dst[i*32:]
+			for w := 0; w < 8; w++ {
+				binary.BigEndian.PutUint32(d[w*4:], sc.st32[w*L+l])
+			}
+		}
+	}
+}
+
+// sha256Pad fills b with block j of the padded message s of n blocks.
+func sha256Pad(b []byte, s string, j, n int) {
+	off := j * 64
+	k := 0
+	if off < len(s) {
+		k = copy(b, s[off:])
+	}
+	clear(b[k:])
+	if off <= len(s) && len(s) < off+64 {
+		b[len(s)-off] = 0x80
+	}
+	if j == n-1 {
+		binary.BigEndian.PutUint64(b[56:], uint64(len(s))*8)
+	}
+}
+
+const keccakRate = 136 // байт на блок у SHA3-256
+
+// keccakMB is SHA3-256 on lanes messages at a time; permute applies
+// Keccak-f[1600] to each lane.
+type keccakMB struct {
+	backend string
+	lanes   int
+	permute func(state *uint64)
+}
+
+func (m *keccakMB) name() string { return m.backend }
+
+func (m *keccakMB) sum(dst []byte, in []string, one *oneState) {
+	sc := mbScratches.Get().(*mbScratch)
+	defer mbScratches.Put(sc)
+	groups(sc, dst, in, m.lanes, keccakRate, one, func(idx []int) { m.group(sc, dst, in, idx) })
+}
+
+func (m *keccakMB) group(sc *mbScratch, dst []byte, in []string, idx []int) {
+	L := m.lanes
+	clear(sc.st64[:25*L])
+	var blocks [mbMaxLanes]int
+	last := 0
+	for l, i := range idx {
+		blocks[l] = len(in[i])/keccakRate + 1
+		last = max(last, blocks[l])
+	}
+
+	for j := 0; j < last; j++ {
+		for l, i := range idx {
+			if j >= blocks[l] {
+				continue
+			}
+			b := sc.block[:]
+			keccakPad(b, in[i], j, blocks[l])
+			for w := 0; w < keccakRate/8; w++ {
+				sc.st64[w*L+l] ^= binary.LittleEndian.Uint64(b[w*8:])
+			}
+		}
+		m.permute(&sc.st64[0])
+		for l, i := range idx {
+			if j != blocks[l]-1 {
+				continue
+			}
+			d := dst[i*32:]
+			for w := 0; w < 4; w++ {
+				binary.LittleEndian.PutUint64(d[w*8:], sc.st64[w*L+l])
+			}
+		}
+	}
+}
+
+// keccakPad fills b with block j of the SHA3-padded message s of n blocks.
+func keccakPad(b []byte, s string, j, n int) {
+	off := j * keccakRate
+	k := 0
+	if off < len(s) {
+		k = copy(b, s[off:])
+	}
+	clear(b[k:])
+	if j == n-1 {
+		b[len(s)-off] ^= 0x06
+		b[keccakRate-1] ^= 0x80
+	}
+}
@@ -0,0 +1,125 @@
+package hasher
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+// parityInput covers every padding boundary of both block sizes, strings
+// over mbMaxLen and a mix of lengths in one batch.
+func parityInput() []string {
+	var in []string
+	for n := 0; n <= 300; n++ {
+		in = append(in, strings.Repeat(string(rune('a'+n%26)), n))
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 500; i++ {
+		b := make([]byte, rng.IntN(mbMaxLen+200))
+		for j := range b {
+			b[j] = byte(rng.Uint32())
+		}
+		in = append(in, string(b))
+	}
+	return in
+}
+
+func reference(in []string, sum func([]byte) []byte) []byte {
+	out := make([]byte, 0, len(in)*32)
+	for _, s := range in {
+		out = append(out, sum([]byte(s))...)
+	}
+	return out
+}
+
+func TestMultiBuffer_Parity(t *testing.T) {
+	in := parityInput()
+	wantSHA256 := reference(in, func(b []byte) []byte { s := sha256.Sum256(b); return s[:] })
+	wantSHA3 := reference(in, func(b []byte) []byte { s := sha3.Sum256(b); return s[:] })
+
+	check := func(t *testing.T, mb multiBuffer, newHash func() *oneState, want []byte) {
+		// любое число строк: неполные группы и хвосты идут по одной
+		for _, n := range []int{1, 3, 4, 7, 8, 15, 16, 17, len(in)} {
+			got := make([]byte, n*32)
+			mb.sum(got, in[:n], newHash())
+			require.Equal(t, hex.EncodeToString(want[:n*32]), hex.EncodeToString(got), "n=%d", n)
+		}
+	}
+	if len(sha256Backends)+len(keccakBackends) == 0 {
+		t.Skip("no multi-buffer backends on this CPU")
+	}
+	for _, mb := range sha256Backends {
+		t.Run("sha256/"+mb.backend, func(t *testing.T) {
+			check(t, mb, func() *oneState { return &oneState{h: sha256.New()} }, wantSHA256)
+		})
+	}
+	for _, mb := range keccakBackends {
+		t.Run("sha3-256/"+mb.backend, func(t *testing.T) {
+			check(t, mb, func() *oneState { return &oneState{h: sha3.New256()} }, wantSHA3)
+		})
+	}
+}
+
+func TestAlgorithm_Sum(t *testing.T) {
+	in := parityInput()
+	for _, a := range Algorithms() {
+		t.Run(a.Name(), func(t *testing.T) {
+			got := make([]byte, len(in)*a.Size())
+			a.Sum(got, in)
+			for i, s := range in {
+				h := a.New()
+				h.Write([]byte(s))
+				require.Equal(t, h.Sum(nil), got[i*32:(i+1)*32], "item %d", i)
+			}
+		})
+	}
+
+	a, err := Lookup("sha256")
+	require.NoError(t, err)
+	require.Equal(t, SHA256, a)
+	_, err = Lookup("md5")
+	require.Error(t, err)
+}
+
+func BenchmarkMultiBuffer(b *testing.B) {
+	in := benchInput(1024)
+	dst := make([]byte, len(in)*32)
+	size := 0
+	for _, s := range in {
+		size += len(s)
+	}
+	run := func(name string, sum func()) {
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for b.Loop() {
+				sum()
+			}
+		})
+	}
+
+	run("sha256/generic", func() {
+		st := &oneState{h: sha256.New()}
+		for i, s := range in {
+			st.sum(dst[i*32:], s)
+		}
+	})
+	for _, mb := range sha256Backends {
+		st := &oneState{h: sha256.New()}
+		run("sha256/"+mb.backend, func() { mb.sum(dst, in, st) })
+	}
+	run("sha3-256/generic", func() {
+		st := &oneState{h: sha3.New256()}
+		for i, s := range in {
+			st.sum(dst[i*32:], s)
+		}
+	})
+	for _, mb := range keccakBackends {
+		st := &oneState{h: sha3.New256()}
+		run("sha3-256/"+mb.backend, func() { mb.sum(dst, in, st) })
+	}
+}
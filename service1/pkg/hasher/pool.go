@@ -0,0 +1,244 @@
+package hasher
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// ErrOverloaded is returned by Pool.Hash when the queue has no room for the
+// request at its priority.
+var ErrOverloaded = errors.New("hasher: queue is full")
+
+// ErrPoolClosed is returned by Pool.Hash after Close.
+var ErrPoolClosed = errors.New("hasher: pool is closed")
+
+// Priority orders requests waiting in a Pool. Higher priorities are taken
+// first and may fill more of the queue, so low priority work is shed first
+// under load.
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+
+	numPriorities = 3
+)
+
+// queueShare — доля очереди, которую может занять запрос данного приоритета
+var queueShare = [numPriorities]float64{
+	PriorityLow:    0.5,
+	PriorityNormal: 0.8,
+	PriorityHigh:   1,
+}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityHigh:
+		return "high"
+	}
+	return "normal"
+}
+
+// ParsePriority parses "low", "normal" or "high".
+func ParsePriority(s string) (Priority, bool) {
+	switch s {
+	case "low":
+		return PriorityLow, true
+	case "normal":
+		return PriorityNormal, true
+	case "high":
+		return PriorityHigh, true
+	}
+	return PriorityNormal, false
+}
+
+// minChunk — меньше строк в одну задачу не нарезаем: планирование дороже хеширования
+const minChunk = 32
+
+// Pool hashes strings on a fixed set of workers shared by all requests, so
+// the number of hashing goroutines does not grow with the number of requests.
+// A request is split into contiguous chunks, one per worker at most, which
+// wait in a queue bounded by the total number of strings.
+type Pool struct {
+	workers  int
+	capacity int
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queues [numPriorities][]*task
+	queued int // строк в очереди
+	busy   int
+	closed bool
+	wg     sync.WaitGroup
+}
+
+type task struct {
+	ctx context.Context
+	alg Algorithm
+	in  []string
+	out []string
+	req *request
+}
+
+type request struct {
+	mu      sync.Mutex
+	pending int
+	done    chan struct{}
+}
+
+// NewPool starts workers goroutines (runtime.NumCPU() if workers <= 0) that
+// serve a queue of up to capacity strings.
+func NewPool(workers, capacity int) *Pool {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	p := &Pool{workers: workers, capacity: capacity}
+	p.cond = sync.NewCond(&p.mu)
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// Hash hashes input by alg like HashStrings. It fails with ErrOverloaded
+// without doing any work if the queue cannot take the request at prio. An
+// empty queue always takes a request, however large. Requests of up to
+// seqThreshold strings that are admitted are hashed by the caller, without
+// waiting for a worker.
+func (p *Pool) Hash(ctx context.Context, alg Algorithm, prio Priority, input []string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	out := make([]string, len(input))
+	if len(input) == 0 {
+		return out, nil
+	}
+	if prio < PriorityLow || prio > PriorityHigh {
+		prio = PriorityNormal
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+	if p.queued > 0 && float64(p.queued+len(input)) > queueShare[prio]*float64(p.capacity) {
+		p.mu.Unlock()
+		return nil, ErrOverloaded
+	}
+	if len(input) <= seqThreshold {
+		p.mu.Unlock()
+		// очередь и пробуждение воркера обходятся дороже самого хеширования
+		hashRange(alg, input, out)
+		return out, nil
+	}
+
+	chunk := (len(input) + p.workers - 1) / p.workers
+	if chunk < minChunk {
+		chunk = minChunk
+	}
+	req := &request{done: make(chan struct{})}
+	for lo := 0; lo < len(input); lo += chunk {
+		hi := min(lo+chunk, len(input))
+		p.queues[prio] = append(p.queues[prio], &task{ctx: ctx, alg: alg, in: input[lo:hi], out: out[lo:hi], req: req})
+		req.pending++
+	}
+	p.queued += len(input)
+	p.mu.Unlock()
+	p.cond.Broadcast()
+
+	select {
+	case <-req.done:
+	case <-ctx.Done():
+		// задачи, оставшиеся в очереди, воркеры пропустят
+		return nil, ctx.Err()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Workers returns the number of workers.
+func (p *Pool) Workers() int {
+	return p.workers
+}
+
+// Queued returns the number of strings waiting in the queue.
+func (p *Pool) Queued() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.queued
+}
+
+// Busy returns the number of workers hashing right now.
+func (p *Pool) Busy() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.busy
+}
+
+// Close stops the workers once the queue is drained. Hash fails with
+// ErrPoolClosed afterwards.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+	p.cond.Broadcast()
+	p.wg.Wait()
+}
+
+func (p *Pool) work() {
+	defer p.wg.Done()
+	for {
+		t := p.next()
+		if t == nil {
+			return
+		}
+		if t.ctx.Err() == nil {
+			hashRange(t.alg, t.in, t.out)
+		}
+
+		p.mu.Lock()
+		p.busy--
+		p.mu.Unlock()
+
+		t.req.mu.Lock()
+		t.req.pending--
+		if t.req.pending == 0 {
+			close(t.req.done)
+		}
+		t.req.mu.Unlock()
+	}
+}
+
+// next blocks until there is a task and takes the one of highest priority,
+// or returns nil when the pool is closed and drained.
+func (p *Pool) next() *task {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for {
+		for prio := PriorityHigh; prio >= PriorityLow; prio-- {
+			q := p.queues[prio]
+			if len(q) == 0 {
+				continue
+			}
+			t := q[0]
+			q[0] = nil
+			p.queues[prio] = q[1:]
+			p.queued -= len(t.in)
+			p.busy++
+			return t
+		}
+		if p.closed {
+			return nil
+		}
+		p.cond.Wait()
+	}
+}
@@ -0,0 +1,149 @@
+package hasher
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stoppedPool returns a pool whose workers are started by the returned
+// function, so that the queue can be filled first.
+func stoppedPool(workers, capacity int) (*Pool, func()) {
+	p := &Pool{workers: workers, capacity: capacity}
+	p.cond = sync.NewCond(&p.mu)
+	return p, func() {
+		p.wg.Add(workers)
+		for i := 0; i < workers; i++ {
+			go p.work()
+		}
+	}
+}
+
+// queuedBatch returns n strings, more than are hashed inline.
+func queuedBatch(n int) []string {
+	return make([]string, max(n, seqThreshold+1))
+}
+
+func waitQueued(t *testing.T, p *Pool, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return p.Queued() == n }, time.Second, time.Millisecond)
+}
+
+func TestPool_MatchesParallel(t *testing.T) {
+	p := NewPool(4, 100000)
+	defer p.Close()
+
+	in := make([]string, 1000)
+	for i := range in {
+		in[i] = string(rune('a' + i%26))
+	}
+	want, err := HashStringsParallel(context.Background(), in)
+	require.NoError(t, err)
+	got, err := p.Hash(context.Background(), SHA3_256, PriorityNormal, in)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	got, err = p.Hash(context.Background(), SHA3_256, PriorityHigh, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 0)
+}
+
+func TestPool_Admission(t *testing.T) {
+	p, start := stoppedPool(1, 1000)
+	defer p.Close()
+
+	ctx := context.Background()
+	go func() { _, _ = p.Hash(ctx, SHA3_256, PriorityLow, queuedBatch(400)) }()
+	waitQueued(t, p, 400)
+
+	// low может занять половину очереди, normal — 80%, high — всю
+	_, err := p.Hash(ctx, SHA3_256, PriorityLow, queuedBatch(200))
+	require.ErrorIs(t, err, ErrOverloaded)
+	go func() { _, _ = p.Hash(ctx, SHA3_256, PriorityNormal, queuedBatch(400)) }()
+	waitQueued(t, p, 800)
+	// мелкие вызовы отклоняются так же, хоть и хешируются на месте
+	_, err = p.Hash(ctx, SHA3_256, PriorityNormal, make([]string, 1))
+	require.ErrorIs(t, err, ErrOverloaded)
+
+	done := make(chan error)
+	go func() {
+		_, err := p.Hash(ctx, SHA3_256, PriorityHigh, queuedBatch(200))
+		done <- err
+	}()
+	waitQueued(t, p, 1000)
+
+	start()
+	require.NoError(t, <-done)
+}
+
+func TestPool_PriorityOrder(t *testing.T) {
+	p, start := stoppedPool(1, 1000)
+	defer func() {
+		start()
+		p.Close()
+	}()
+
+	ctx := context.Background()
+	low, high := queuedBatch(0), queuedBatch(0)
+	low[0], high[0] = "low", "high"
+	go func() { _, _ = p.Hash(ctx, SHA3_256, PriorityLow, low) }()
+	waitQueued(t, p, len(low))
+	go func() { _, _ = p.Hash(ctx, SHA3_256, PriorityHigh, high) }()
+	waitQueued(t, p, len(low)+len(high))
+
+	// берём задачи вместо воркера, возвращая их обратно после проверки
+	first, second := p.next(), p.next()
+	require.Equal(t, high, first.in)
+	require.Equal(t, low, second.in)
+	for _, tk := range []*task{first, second} {
+		hashRange(tk.alg, tk.in, tk.out)
+		p.mu.Lock()
+		p.busy--
+		p.mu.Unlock()
+		close(tk.req.done)
+	}
+}
+
+func TestPool_Cancel(t *testing.T) {
+	p, start := stoppedPool(1, 1000)
+	defer p.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := p.Hash(ctx, SHA3_256, PriorityNormal, queuedBatch(0))
+		done <- err
+	}()
+	waitQueued(t, p, seqThreshold+1)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	// отменённая задача не мешает следующим
+	start()
+	_, err := p.Hash(context.Background(), SHA3_256, PriorityNormal, []string{"y"})
+	require.NoError(t, err)
+	waitQueued(t, p, 0)
+}
+
+func TestPool_SmallInline(t *testing.T) {
+	// воркеры не запущены: мелкий вызов хешируется вызывающим
+	p, _ := stoppedPool(1, 100)
+	defer p.Close()
+	in := benchInput(seqThreshold)
+	want, err := HashStrings(context.Background(), SHA3_256, in)
+	require.NoError(t, err)
+	got, err := p.Hash(context.Background(), SHA3_256, PriorityNormal, in)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	require.Zero(t, p.Queued())
+}
+
+func TestPool_Closed(t *testing.T) {
+	p := NewPool(1, 10)
+	p.Close()
+	_, err := p.Hash(context.Background(), SHA3_256, PriorityNormal, []string{"x"})
+	require.ErrorIs(t, err, ErrPoolClosed)
+}
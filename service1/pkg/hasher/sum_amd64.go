@@ -0,0 +1,47 @@
+//go:build amd64 && !purego
+
+package hasher
+
+import "golang.org/x/sys/cpu"
+
+//go:generate go run asm_gen.go
+
+//go:noescape
+func sha256BlockAVX2(state *uint32, msg *uint32)
+
+//go:noescape
+func sha256BlockAVX512(state *uint32, msg *uint32)
+
+//go:noescape
+func keccakF1600AVX2(state *uint64)
+
+//go:noescape
+func keccakF1600AVX512(state *uint64)
+
+// Ядра, которые поддерживает CPU, быстрые первыми.
+var (
+	sha256Backends = detectSHA256()
+	keccakBackends = detectKeccak()
+)
+
+func detectSHA256() []*sha256MB {
+	var out []*sha256MB
+	if cpu.X86.HasAVX512F {
+		out = append(out, &sha256MB{backend: "avx512", lanes: 16, block: sha256BlockAVX512})
+	}
+	if cpu.X86.HasAVX2 {
+		out = append(out, &sha256MB{backend: "avx2", lanes: 8, block: sha256BlockAVX2})
+	}
+	return out
+}
+
+func detectKeccak() []*keccakMB {
+	var out []*keccakMB
+	if cpu.X86.HasAVX512F {
+		out = append(out, &keccakMB{backend: "avx512", lanes: 8, permute: keccakF1600AVX512})
+	}
+	if cpu.X86.HasAVX2 {
+		out = append(out, &keccakMB{backend: "avx2", lanes: 4, permute: keccakF1600AVX2})
+	}
+	return out
+}
@@ -0,0 +1,8983 @@
+// Code generated by asm_gen.go. DO NOT EDIT.
+
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+DATA sha256K<>+0(SB)/4, $0x428a2f98
+DATA sha256K<>+4(SB)/4, $0x71374491
+DATA sha256K<>+8(SB)/4, $0xb5c0fbcf
+DATA sha256K<>+12(SB)/4, $0xe9b5dba5
+DATA sha256K<>+16(SB)/4, $0x3956c25b
+DATA sha256K<>+20(SB)/4, $0x59f111f1
+DATA sha256K<>+24(SB)/4, $0x923f82a4
+DATA sha256K<>+28(SB)/4, $0xab1c5ed5
+DATA sha256K<>+32(SB)/4, $0xd807aa98
+DATA sha256K<>+36(SB)/4, $0x12835b01
+DATA sha256K<>+40(SB)/4, $0x243185be
+DATA sha256K<>+44(SB)/4, $0x550c7dc3
+DATA sha256K<>+48(SB)/4, $0x72be5d74
+DATA sha256K<>+52(SB)/4, $0x80deb1fe
+DATA sha256K<>+56(SB)/4, $0x9bdc06a7
+DATA sha256K<>+60(SB)/4, $0xc19bf174
+DATA sha256K<>+64(SB)/4, $0xe49b69c1
+DATA sha256K<>+68(SB)/4, $0xefbe4786
+DATA sha256K<>+72(SB)/4, $0x0fc19dc6
+DATA sha256K<>+76(SB)/4, $0x240ca1cc
+DATA sha256K<>+80(SB)/4, $0x2de92c6f
+DATA sha256K<>+84(SB)/4, $0x4a7484aa
+DATA sha256K<>+88(SB)/4, $0x5cb0a9dc
+DATA sha256K<>+92(SB)/4, $0x76f988da
+DATA sha256K<>+96(SB)/4, $0x983e5152
+DATA sha256K<>+100(SB)/4, $0xa831c66d
+DATA sha256K<>+104(SB)/4, $0xb00327c8
+DATA sha256K<>+108(SB)/4, $0xbf597fc7
+DATA sha256K<>+112(SB)/4, $0xc6e00bf3
+DATA sha256K<>+116(SB)/4, $0xd5a79147
+DATA sha256K<>+120(SB)/4, $0x06ca6351
+DATA sha256K<>+124(SB)/4, $0x14292967
+DATA sha256K<>+128(SB)/4, $0x27b70a85
+DATA sha256K<>+132(SB)/4, $0x2e1b2138
+DATA sha256K<>+136(SB)/4, $0x4d2c6dfc
+DATA sha256K<>+140(SB)/4, $0x53380d13
+DATA sha256K<>+144(SB)/4, $0x650a7354
+DATA sha256K<>+148(SB)/4, $0x766a0abb
+DATA sha256K<>+152(SB)/4, $0x81c2c92e
+DATA sha256K<>+156(SB)/4, $0x92722c85
+DATA sha256K<>+160(SB)/4, $0xa2bfe8a1
+DATA sha256K<>+164(SB)/4, $0xa81a664b
+DATA sha256K<>+168(SB)/4, $0xc24b8b70
+DATA sha256K<>+172(SB)/4, $0xc76c51a3
+DATA sha256K<>+176(SB)/4, $0xd192e819
+DATA sha256K<>+180(SB)/4, $0xd6990624
+DATA sha256K<>+184(SB)/4, $0xf40e3585
+DATA sha256K<>+188(SB)/4, $0x106aa070
+DATA sha256K<>+192(SB)/4, $0x19a4c116
+DATA sha256K<>+196(SB)/4, $0x1e376c08
+DATA sha256K<>+200(SB)/4, $0x2748774c
+DATA sha256K<>+204(SB)/4, $0x34b0bcb5
+DATA sha256K<>+208(SB)/4, $0x391c0cb3
+DATA sha256K<>+212(SB)/4, $0x4ed8aa4a
+DATA sha256K<>+216(SB)/4, $0x5b9cca4f
+DATA sha256K<>+220(SB)/4, $0x682e6ff3
+DATA sha256K<>+224(SB)/4, $0x748f82ee
+DATA sha256K<>+228(SB)/4, $0x78a5636f
+DATA sha256K<>+232(SB)/4, $0x84c87814
+DATA sha256K<>+236(SB)/4, $0x8cc70208
+DATA sha256K<>+240(SB)/4, $0x90befffa
+DATA sha256K<>+244(SB)/4, $0xa4506ceb
+DATA sha256K<>+248(SB)/4, $0xbef9a3f7
+DATA sha256K<>+252(SB)/4, $0xc67178f2
+GLOBL sha256K<>(SB), RODATA|NOPTR, $256
+
+DATA keccakRC<>+0(SB)/8, $0x0000000000000001
+DATA keccakRC<>+8(SB)/8, $0x0000000000008082
+DATA keccakRC<>+16(SB)/8, $0x800000000000808a
+DATA keccakRC<>+24(SB)/8, $0x8000000080008000
+DATA keccakRC<>+32(SB)/8, $0x000000000000808b
+DATA keccakRC<>+40(SB)/8, $0x0000000080000001
+DATA keccakRC<>+48(SB)/8, $0x8000000080008081
+DATA keccakRC<>+56(SB)/8, $0x8000000000008009
+DATA keccakRC<>+64(SB)/8, $0x000000000000008a
+DATA keccakRC<>+72(SB)/8, $0x0000000000000088
+DATA keccakRC<>+80(SB)/8, $0x0000000080008009
+DATA keccakRC<>+88(SB)/8, $0x000000008000000a
+DATA keccakRC<>+96(SB)/8, $0x000000008000808b
+DATA keccakRC<>+104(SB)/8, $0x800000000000008b
+DATA keccakRC<>+112(SB)/8, $0x8000000000008089
+DATA keccakRC<>+120(SB)/8, $0x8000000000008003
+DATA keccakRC<>+128(SB)/8, $0x8000000000008002
+DATA keccakRC<>+136(SB)/8, $0x8000000000000080
+DATA keccakRC<>+144(SB)/8, $0x000000000000800a
+DATA keccakRC<>+152(SB)/8, $0x800000008000000a
+DATA keccakRC<>+160(SB)/8, $0x8000000080008081
+DATA keccakRC<>+168(SB)/8, $0x8000000000008080
+DATA keccakRC<>+176(SB)/8, $0x0000000080000001
+DATA keccakRC<>+184(SB)/8, $0x8000000080008008
+GLOBL keccakRC<>(SB), RODATA|NOPTR, $192
+
+// func sha256BlockAVX2(state *[8 * 8]uint32, msg *[16 * 8]uint32)
+TEXT ·sha256BlockAVX2(SB), 0, $2048-16
+	MOVQ state+0(FP), AX
+	MOVQ msg+8(FP), SI
+	LEAQ sha256K<>(SB), BX
+
+	// W[0..15] = msg
+	VMOVDQU 0(SI), Y8
+	VMOVDQU Y8, 0(SP)
+	VMOVDQU 32(SI), Y8
+	VMOVDQU Y8, 32(SP)
+	VMOVDQU 64(SI), Y8
+	VMOVDQU Y8, 64(SP)
+	VMOVDQU 96(SI), Y8
+	VMOVDQU Y8, 96(SP)
+	VMOVDQU 128(SI), Y8
+	VMOVDQU Y8, 128(SP)
+	VMOVDQU 160(SI), Y8
+	VMOVDQU Y8, 160(SP)
+	VMOVDQU 192(SI), Y8
+	VMOVDQU Y8, 192(SP)
+	VMOVDQU 224(SI), Y8
+	VMOVDQU Y8, 224(SP)
+	VMOVDQU 256(SI), Y8
+	VMOVDQU Y8, 256(SP)
+	VMOVDQU 288(SI), Y8
+	VMOVDQU Y8, 288(SP)
+	VMOVDQU 320(SI), Y8
+	VMOVDQU Y8, 320(SP)
+	VMOVDQU 352(SI), Y8
+	VMOVDQU Y8, 352(SP)
+	VMOVDQU 384(SI), Y8
+	VMOVDQU Y8, 384(SP)
+	VMOVDQU 416(SI), Y8
+	VMOVDQU Y8, 416(SP)
+	VMOVDQU 448(SI), Y8
+	VMOVDQU Y8, 448(SP)
+	VMOVDQU 480(SI), Y8
+	VMOVDQU Y8, 480(SP)
+
+	// W[16..63]
+	VMOVDQU 448(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 32(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 288(SP), Y10, Y10
+	VPADDD 0(SP), Y10, Y10
+	VMOVDQU Y10, 512(SP)
+	VMOVDQU 480(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 64(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 320(SP), Y10, Y10
+	VPADDD 32(SP), Y10, Y10
+	VMOVDQU Y10, 544(SP)
+	VMOVDQU 512(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 96(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 352(SP), Y10, Y10
+	VPADDD 64(SP), Y10, Y10
+	VMOVDQU Y10, 576(SP)
+	VMOVDQU 544(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 128(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 384(SP), Y10, Y10
+	VPADDD 96(SP), Y10, Y10
+	VMOVDQU Y10, 608(SP)
+	VMOVDQU 576(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 160(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 416(SP), Y10, Y10
+	VPADDD 128(SP), Y10, Y10
+	VMOVDQU Y10, 640(SP)
+	VMOVDQU 608(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 192(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 448(SP), Y10, Y10
+	VPADDD 160(SP), Y10, Y10
+	VMOVDQU Y10, 672(SP)
+	VMOVDQU 640(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 224(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 480(SP), Y10, Y10
+	VPADDD 192(SP), Y10, Y10
+	VMOVDQU Y10, 704(SP)
+	VMOVDQU 672(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 256(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 512(SP), Y10, Y10
+	VPADDD 224(SP), Y10, Y10
+	VMOVDQU Y10, 736(SP)
+	VMOVDQU 704(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 288(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 544(SP), Y10, Y10
+	VPADDD 256(SP), Y10, Y10
+	VMOVDQU Y10, 768(SP)
+	VMOVDQU 736(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 320(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 576(SP), Y10, Y10
+	VPADDD 288(SP), Y10, Y10
+	VMOVDQU Y10, 800(SP)
+	VMOVDQU 768(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 352(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 608(SP), Y10, Y10
+	VPADDD 320(SP), Y10, Y10
+	VMOVDQU Y10, 832(SP)
+	VMOVDQU 800(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 384(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 640(SP), Y10, Y10
+	VPADDD 352(SP), Y10, Y10
+	VMOVDQU Y10, 864(SP)
+	VMOVDQU 832(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 416(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 672(SP), Y10, Y10
+	VPADDD 384(SP), Y10, Y10
+	VMOVDQU Y10, 896(SP)
+	VMOVDQU 864(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 448(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 704(SP), Y10, Y10
+	VPADDD 416(SP), Y10, Y10
+	VMOVDQU Y10, 928(SP)
+	VMOVDQU 896(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 480(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 736(SP), Y10, Y10
+	VPADDD 448(SP), Y10, Y10
+	VMOVDQU Y10, 960(SP)
+	VMOVDQU 928(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 512(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 768(SP), Y10, Y10
+	VPADDD 480(SP), Y10, Y10
+	VMOVDQU Y10, 992(SP)
+	VMOVDQU 960(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 544(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 800(SP), Y10, Y10
+	VPADDD 512(SP), Y10, Y10
+	VMOVDQU Y10, 1024(SP)
+	VMOVDQU 992(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 576(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 832(SP), Y10, Y10
+	VPADDD 544(SP), Y10, Y10
+	VMOVDQU Y10, 1056(SP)
+	VMOVDQU 1024(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 608(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 864(SP), Y10, Y10
+	VPADDD 576(SP), Y10, Y10
+	VMOVDQU Y10, 1088(SP)
+	VMOVDQU 1056(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 640(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 896(SP), Y10, Y10
+	VPADDD 608(SP), Y10, Y10
+	VMOVDQU Y10, 1120(SP)
+	VMOVDQU 1088(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 672(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 928(SP), Y10, Y10
+	VPADDD 640(SP), Y10, Y10
+	VMOVDQU Y10, 1152(SP)
+	VMOVDQU 1120(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 704(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 960(SP), Y10, Y10
+	VPADDD 672(SP), Y10, Y10
+	VMOVDQU Y10, 1184(SP)
+	VMOVDQU 1152(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 736(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 992(SP), Y10, Y10
+	VPADDD 704(SP), Y10, Y10
+	VMOVDQU Y10, 1216(SP)
+	VMOVDQU 1184(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 768(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1024(SP), Y10, Y10
+	VPADDD 736(SP), Y10, Y10
+	VMOVDQU Y10, 1248(SP)
+	VMOVDQU 1216(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 800(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1056(SP), Y10, Y10
+	VPADDD 768(SP), Y10, Y10
+	VMOVDQU Y10, 1280(SP)
+	VMOVDQU 1248(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 832(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1088(SP), Y10, Y10
+	VPADDD 800(SP), Y10, Y10
+	VMOVDQU Y10, 1312(SP)
+	VMOVDQU 1280(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 864(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1120(SP), Y10, Y10
+	VPADDD 832(SP), Y10, Y10
+	VMOVDQU Y10, 1344(SP)
+	VMOVDQU 1312(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 896(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1152(SP), Y10, Y10
+	VPADDD 864(SP), Y10, Y10
+	VMOVDQU Y10, 1376(SP)
+	VMOVDQU 1344(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 928(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1184(SP), Y10, Y10
+	VPADDD 896(SP), Y10, Y10
+	VMOVDQU Y10, 1408(SP)
+	VMOVDQU 1376(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 960(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1216(SP), Y10, Y10
+	VPADDD 928(SP), Y10, Y10
+	VMOVDQU Y10, 1440(SP)
+	VMOVDQU 1408(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 992(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1248(SP), Y10, Y10
+	VPADDD 960(SP), Y10, Y10
+	VMOVDQU Y10, 1472(SP)
+	VMOVDQU 1440(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1024(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1280(SP), Y10, Y10
+	VPADDD 992(SP), Y10, Y10
+	VMOVDQU Y10, 1504(SP)
+	VMOVDQU 1472(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1056(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1312(SP), Y10, Y10
+	VPADDD 1024(SP), Y10, Y10
+	VMOVDQU Y10, 1536(SP)
+	VMOVDQU 1504(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1088(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1344(SP), Y10, Y10
+	VPADDD 1056(SP), Y10, Y10
+	VMOVDQU Y10, 1568(SP)
+	VMOVDQU 1536(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1120(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1376(SP), Y10, Y10
+	VPADDD 1088(SP), Y10, Y10
+	VMOVDQU Y10, 1600(SP)
+	VMOVDQU 1568(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1152(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1408(SP), Y10, Y10
+	VPADDD 1120(SP), Y10, Y10
+	VMOVDQU Y10, 1632(SP)
+	VMOVDQU 1600(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1184(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1440(SP), Y10, Y10
+	VPADDD 1152(SP), Y10, Y10
+	VMOVDQU Y10, 1664(SP)
+	VMOVDQU 1632(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1216(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1472(SP), Y10, Y10
+	VPADDD 1184(SP), Y10, Y10
+	VMOVDQU Y10, 1696(SP)
+	VMOVDQU 1664(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1248(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1504(SP), Y10, Y10
+	VPADDD 1216(SP), Y10, Y10
+	VMOVDQU Y10, 1728(SP)
+	VMOVDQU 1696(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1280(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1536(SP), Y10, Y10
+	VPADDD 1248(SP), Y10, Y10
+	VMOVDQU Y10, 1760(SP)
+	VMOVDQU 1728(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1312(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1568(SP), Y10, Y10
+	VPADDD 1280(SP), Y10, Y10
+	VMOVDQU Y10, 1792(SP)
+	VMOVDQU 1760(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1344(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1600(SP), Y10, Y10
+	VPADDD 1312(SP), Y10, Y10
+	VMOVDQU Y10, 1824(SP)
+	VMOVDQU 1792(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1376(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1632(SP), Y10, Y10
+	VPADDD 1344(SP), Y10, Y10
+	VMOVDQU Y10, 1856(SP)
+	VMOVDQU 1824(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1408(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1664(SP), Y10, Y10
+	VPADDD 1376(SP), Y10, Y10
+	VMOVDQU Y10, 1888(SP)
+	VMOVDQU 1856(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1440(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1696(SP), Y10, Y10
+	VPADDD 1408(SP), Y10, Y10
+	VMOVDQU Y10, 1920(SP)
+	VMOVDQU 1888(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1472(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1728(SP), Y10, Y10
+	VPADDD 1440(SP), Y10, Y10
+	VMOVDQU Y10, 1952(SP)
+	VMOVDQU 1920(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1504(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1760(SP), Y10, Y10
+	VPADDD 1472(SP), Y10, Y10
+	VMOVDQU Y10, 1984(SP)
+	VMOVDQU 1952(SP), Y8
+	VPSRLD $17, Y8, Y13
+	VPSLLD $15, Y8, Y14
+	VPOR Y13, Y14, Y9
+	VPSRLD $19, Y8, Y13
+	VPSLLD $13, Y8, Y14
+	VPXOR Y13, Y9, Y9
+	VPXOR Y14, Y9, Y9
+	VPSRLD $10, Y8, Y13
+	VPXOR Y13, Y9, Y9
+	VMOVDQU 1536(SP), Y8
+	VPSRLD $7, Y8, Y13
+	VPSLLD $25, Y8, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $18, Y8, Y13
+	VPSLLD $14, Y8, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $3, Y8, Y13
+	VPXOR Y13, Y10, Y10
+	VPADDD Y9, Y10, Y10
+	VPADDD 1792(SP), Y10, Y10
+	VPADDD 1504(SP), Y10, Y10
+	VMOVDQU Y10, 2016(SP)
+
+	// a..h
+	VMOVDQU 0(AX), Y0
+	VMOVDQU 32(AX), Y1
+	VMOVDQU 64(AX), Y2
+	VMOVDQU 96(AX), Y3
+	VMOVDQU 128(AX), Y4
+	VMOVDQU 160(AX), Y5
+	VMOVDQU 192(AX), Y6
+	VMOVDQU 224(AX), Y7
+
+	// round 0
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 0(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 0(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 1
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 4(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 32(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 2
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 8(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 64(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 3
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 12(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 96(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 4
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 16(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 128(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 5
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 20(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 160(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 6
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 24(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 192(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 7
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 28(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 224(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// round 8
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 32(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 256(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 9
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 36(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 288(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 10
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 40(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 320(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 11
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 44(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 352(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 12
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 48(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 384(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 13
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 52(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 416(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 14
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 56(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 448(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 15
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 60(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 480(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// round 16
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 64(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 512(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 17
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 68(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 544(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 18
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 72(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 576(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 19
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 76(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 608(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 20
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 80(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 640(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 21
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 84(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 672(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 22
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 88(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 704(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 23
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 92(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 736(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// round 24
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 96(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 768(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 25
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 100(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 800(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 26
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 104(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 832(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 27
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 108(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 864(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 28
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 112(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 896(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 29
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 116(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 928(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 30
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 120(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 960(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 31
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 124(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 992(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// round 32
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 128(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 1024(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 33
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 132(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 1056(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 34
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 136(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 1088(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 35
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 140(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 1120(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 36
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 144(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 1152(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 37
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 148(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 1184(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 38
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 152(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 1216(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 39
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 156(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 1248(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// round 40
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 160(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 1280(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 41
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 164(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 1312(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 42
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 168(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 1344(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 43
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 172(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 1376(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 44
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 176(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 1408(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 45
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 180(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 1440(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 46
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 184(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 1472(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 47
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 188(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 1504(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// round 48
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 192(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 1536(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 49
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 196(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 1568(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 50
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 200(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 1600(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 51
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 204(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 1632(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 52
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 208(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 1664(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 53
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 212(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 1696(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 54
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 216(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 1728(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 55
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 220(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 1760(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// round 56
+	VPSRLD $6, Y4, Y13
+	VPSLLD $26, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y4, Y13
+	VPSLLD $21, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y4, Y13
+	VPSLLD $7, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPAND Y5, Y4, Y8
+	VPANDN Y6, Y4, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y7, Y7
+	VPBROADCASTD 224(BX), Y8
+	VPADDD Y8, Y7, Y7
+	VPADDD 1792(SP), Y7, Y7
+	VPADDD Y7, Y3, Y3
+	VPSRLD $2, Y0, Y13
+	VPSLLD $30, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y0, Y13
+	VPSLLD $19, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y0, Y13
+	VPSLLD $10, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y7, Y7
+	VPOR Y0, Y1, Y8
+	VPAND Y2, Y8, Y8
+	VPAND Y0, Y1, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y7, Y7
+
+	// round 57
+	VPSRLD $6, Y3, Y13
+	VPSLLD $26, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y3, Y13
+	VPSLLD $21, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y3, Y13
+	VPSLLD $7, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPAND Y4, Y3, Y8
+	VPANDN Y5, Y3, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y6, Y6
+	VPBROADCASTD 228(BX), Y8
+	VPADDD Y8, Y6, Y6
+	VPADDD 1824(SP), Y6, Y6
+	VPADDD Y6, Y2, Y2
+	VPSRLD $2, Y7, Y13
+	VPSLLD $30, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y7, Y13
+	VPSLLD $19, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y7, Y13
+	VPSLLD $10, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y6, Y6
+	VPOR Y7, Y0, Y8
+	VPAND Y1, Y8, Y8
+	VPAND Y7, Y0, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y6, Y6
+
+	// round 58
+	VPSRLD $6, Y2, Y13
+	VPSLLD $26, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y2, Y13
+	VPSLLD $21, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y2, Y13
+	VPSLLD $7, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPAND Y3, Y2, Y8
+	VPANDN Y4, Y2, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y5, Y5
+	VPBROADCASTD 232(BX), Y8
+	VPADDD Y8, Y5, Y5
+	VPADDD 1856(SP), Y5, Y5
+	VPADDD Y5, Y1, Y1
+	VPSRLD $2, Y6, Y13
+	VPSLLD $30, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y6, Y13
+	VPSLLD $19, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y6, Y13
+	VPSLLD $10, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y5, Y5
+	VPOR Y6, Y7, Y8
+	VPAND Y0, Y8, Y8
+	VPAND Y6, Y7, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y5, Y5
+
+	// round 59
+	VPSRLD $6, Y1, Y13
+	VPSLLD $26, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y1, Y13
+	VPSLLD $21, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y1, Y13
+	VPSLLD $7, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPAND Y2, Y1, Y8
+	VPANDN Y3, Y1, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y4, Y4
+	VPBROADCASTD 236(BX), Y8
+	VPADDD Y8, Y4, Y4
+	VPADDD 1888(SP), Y4, Y4
+	VPADDD Y4, Y0, Y0
+	VPSRLD $2, Y5, Y13
+	VPSLLD $30, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y5, Y13
+	VPSLLD $19, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y5, Y13
+	VPSLLD $10, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y4, Y4
+	VPOR Y5, Y6, Y8
+	VPAND Y7, Y8, Y8
+	VPAND Y5, Y6, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y4, Y4
+
+	// round 60
+	VPSRLD $6, Y0, Y13
+	VPSLLD $26, Y0, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y0, Y13
+	VPSLLD $21, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y0, Y13
+	VPSLLD $7, Y0, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPAND Y1, Y0, Y8
+	VPANDN Y2, Y0, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y3, Y3
+	VPBROADCASTD 240(BX), Y8
+	VPADDD Y8, Y3, Y3
+	VPADDD 1920(SP), Y3, Y3
+	VPADDD Y3, Y7, Y7
+	VPSRLD $2, Y4, Y13
+	VPSLLD $30, Y4, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y4, Y13
+	VPSLLD $19, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y4, Y13
+	VPSLLD $10, Y4, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y3, Y3
+	VPOR Y4, Y5, Y8
+	VPAND Y6, Y8, Y8
+	VPAND Y4, Y5, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y3, Y3
+
+	// round 61
+	VPSRLD $6, Y7, Y13
+	VPSLLD $26, Y7, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y7, Y13
+	VPSLLD $21, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y7, Y13
+	VPSLLD $7, Y7, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPAND Y0, Y7, Y8
+	VPANDN Y1, Y7, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y2, Y2
+	VPBROADCASTD 244(BX), Y8
+	VPADDD Y8, Y2, Y2
+	VPADDD 1952(SP), Y2, Y2
+	VPADDD Y2, Y6, Y6
+	VPSRLD $2, Y3, Y13
+	VPSLLD $30, Y3, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y3, Y13
+	VPSLLD $19, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y3, Y13
+	VPSLLD $10, Y3, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y2, Y2
+	VPOR Y3, Y4, Y8
+	VPAND Y5, Y8, Y8
+	VPAND Y3, Y4, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y2, Y2
+
+	// round 62
+	VPSRLD $6, Y6, Y13
+	VPSLLD $26, Y6, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y6, Y13
+	VPSLLD $21, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y6, Y13
+	VPSLLD $7, Y6, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPAND Y7, Y6, Y8
+	VPANDN Y0, Y6, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y1, Y1
+	VPBROADCASTD 248(BX), Y8
+	VPADDD Y8, Y1, Y1
+	VPADDD 1984(SP), Y1, Y1
+	VPADDD Y1, Y5, Y5
+	VPSRLD $2, Y2, Y13
+	VPSLLD $30, Y2, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y2, Y13
+	VPSLLD $19, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y2, Y13
+	VPSLLD $10, Y2, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y1, Y1
+	VPOR Y2, Y3, Y8
+	VPAND Y4, Y8, Y8
+	VPAND Y2, Y3, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y1, Y1
+
+	// round 63
+	VPSRLD $6, Y5, Y13
+	VPSLLD $26, Y5, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $11, Y5, Y13
+	VPSLLD $21, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $25, Y5, Y13
+	VPSLLD $7, Y5, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPAND Y6, Y5, Y8
+	VPANDN Y7, Y5, Y9
+	VPXOR Y8, Y9, Y8
+	VPADDD Y8, Y0, Y0
+	VPBROADCASTD 252(BX), Y8
+	VPADDD Y8, Y0, Y0
+	VPADDD 2016(SP), Y0, Y0
+	VPADDD Y0, Y4, Y4
+	VPSRLD $2, Y1, Y13
+	VPSLLD $30, Y1, Y14
+	VPOR Y13, Y14, Y10
+	VPSRLD $13, Y1, Y13
+	VPSLLD $19, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPSRLD $22, Y1, Y13
+	VPSLLD $10, Y1, Y14
+	VPXOR Y13, Y10, Y10
+	VPXOR Y14, Y10, Y10
+	VPADDD Y10, Y0, Y0
+	VPOR Y1, Y2, Y8
+	VPAND Y3, Y8, Y8
+	VPAND Y1, Y2, Y9
+	VPOR Y9, Y8, Y8
+	VPADDD Y8, Y0, Y0
+
+	// state += a..h
+	VPADDD 0(AX), Y0, Y0
+	VMOVDQU Y0, 0(AX)
+	VPADDD 32(AX), Y1, Y1
+	VMOVDQU Y1, 32(AX)
+	VPADDD 64(AX), Y2, Y2
+	VMOVDQU Y2, 64(AX)
+	VPADDD 96(AX), Y3, Y3
+	VMOVDQU Y3, 96(AX)
+	VPADDD 128(AX), Y4, Y4
+	VMOVDQU Y4, 128(AX)
+	VPADDD 160(AX), Y5, Y5
+	VMOVDQU Y5, 160(AX)
+	VPADDD 192(AX), Y6, Y6
+	VMOVDQU Y6, 192(AX)
+	VPADDD 224(AX), Y7, Y7
+	VMOVDQU Y7, 224(AX)
+	VZEROUPPER
+	RET
+
+// func sha256BlockAVX512(state *[8 * 16]uint32, msg *[16 * 16]uint32)
+TEXT ·sha256BlockAVX512(SB), NOSPLIT, $0-16
+	MOVQ state+0(FP), AX
+	MOVQ msg+8(FP), SI
+	LEAQ sha256K<>(SB), BX
+	VMOVDQU32 0(AX), Z0
+	VMOVDQU32 64(AX), Z1
+	VMOVDQU32 128(AX), Z2
+	VMOVDQU32 192(AX), Z3
+	VMOVDQU32 256(AX), Z4
+	VMOVDQU32 320(AX), Z5
+	VMOVDQU32 384(AX), Z6
+	VMOVDQU32 448(AX), Z7
+	VMOVDQU32 0(SI), Z8
+	VMOVDQU32 64(SI), Z9
+	VMOVDQU32 128(SI), Z10
+	VMOVDQU32 192(SI), Z11
+	VMOVDQU32 256(SI), Z12
+	VMOVDQU32 320(SI), Z13
+	VMOVDQU32 384(SI), Z14
+	VMOVDQU32 448(SI), Z15
+	VMOVDQU32 512(SI), Z16
+	VMOVDQU32 576(SI), Z17
+	VMOVDQU32 640(SI), Z18
+	VMOVDQU32 704(SI), Z19
+	VMOVDQU32 768(SI), Z20
+	VMOVDQU32 832(SI), Z21
+	VMOVDQU32 896(SI), Z22
+	VMOVDQU32 960(SI), Z23
+
+	// round 0
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 0(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z8, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 1
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 4(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z9, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 2
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 8(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z10, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 3
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 12(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z11, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 4
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 16(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z12, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 5
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 20(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z13, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 6
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 24(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z14, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 7
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 28(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z15, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// round 8
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 32(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z16, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 9
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 36(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z17, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 10
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 40(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z18, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 11
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 44(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z19, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 12
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 48(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z20, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 13
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 52(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z21, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 14
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 56(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z22, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 15
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 60(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z23, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// round 16
+	VPRORD $17, Z22, Z24
+	VPRORD $19, Z22, Z25
+	VPSRLD $10, Z22, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z9, Z25
+	VPRORD $18, Z9, Z26
+	VPSRLD $3, Z9, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z8, Z8
+	VPADDD Z25, Z8, Z8
+	VPADDD Z17, Z8, Z8
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 64(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z8, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 17
+	VPRORD $17, Z23, Z24
+	VPRORD $19, Z23, Z25
+	VPSRLD $10, Z23, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z10, Z25
+	VPRORD $18, Z10, Z26
+	VPSRLD $3, Z10, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z9, Z9
+	VPADDD Z25, Z9, Z9
+	VPADDD Z18, Z9, Z9
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 68(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z9, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 18
+	VPRORD $17, Z8, Z24
+	VPRORD $19, Z8, Z25
+	VPSRLD $10, Z8, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z11, Z25
+	VPRORD $18, Z11, Z26
+	VPSRLD $3, Z11, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z10, Z10
+	VPADDD Z25, Z10, Z10
+	VPADDD Z19, Z10, Z10
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 72(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z10, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 19
+	VPRORD $17, Z9, Z24
+	VPRORD $19, Z9, Z25
+	VPSRLD $10, Z9, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z12, Z25
+	VPRORD $18, Z12, Z26
+	VPSRLD $3, Z12, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z11, Z11
+	VPADDD Z25, Z11, Z11
+	VPADDD Z20, Z11, Z11
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 76(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z11, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 20
+	VPRORD $17, Z10, Z24
+	VPRORD $19, Z10, Z25
+	VPSRLD $10, Z10, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z13, Z25
+	VPRORD $18, Z13, Z26
+	VPSRLD $3, Z13, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z12, Z12
+	VPADDD Z25, Z12, Z12
+	VPADDD Z21, Z12, Z12
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 80(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z12, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 21
+	VPRORD $17, Z11, Z24
+	VPRORD $19, Z11, Z25
+	VPSRLD $10, Z11, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z14, Z25
+	VPRORD $18, Z14, Z26
+	VPSRLD $3, Z14, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z13, Z13
+	VPADDD Z25, Z13, Z13
+	VPADDD Z22, Z13, Z13
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 84(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z13, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 22
+	VPRORD $17, Z12, Z24
+	VPRORD $19, Z12, Z25
+	VPSRLD $10, Z12, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z15, Z25
+	VPRORD $18, Z15, Z26
+	VPSRLD $3, Z15, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z14, Z14
+	VPADDD Z25, Z14, Z14
+	VPADDD Z23, Z14, Z14
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 88(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z14, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 23
+	VPRORD $17, Z13, Z24
+	VPRORD $19, Z13, Z25
+	VPSRLD $10, Z13, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z16, Z25
+	VPRORD $18, Z16, Z26
+	VPSRLD $3, Z16, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z15, Z15
+	VPADDD Z25, Z15, Z15
+	VPADDD Z8, Z15, Z15
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 92(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z15, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// round 24
+	VPRORD $17, Z14, Z24
+	VPRORD $19, Z14, Z25
+	VPSRLD $10, Z14, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z17, Z25
+	VPRORD $18, Z17, Z26
+	VPSRLD $3, Z17, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z16, Z16
+	VPADDD Z25, Z16, Z16
+	VPADDD Z9, Z16, Z16
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 96(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z16, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 25
+	VPRORD $17, Z15, Z24
+	VPRORD $19, Z15, Z25
+	VPSRLD $10, Z15, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z18, Z25
+	VPRORD $18, Z18, Z26
+	VPSRLD $3, Z18, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z17, Z17
+	VPADDD Z25, Z17, Z17
+	VPADDD Z10, Z17, Z17
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 100(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z17, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 26
+	VPRORD $17, Z16, Z24
+	VPRORD $19, Z16, Z25
+	VPSRLD $10, Z16, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z19, Z25
+	VPRORD $18, Z19, Z26
+	VPSRLD $3, Z19, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z18, Z18
+	VPADDD Z25, Z18, Z18
+	VPADDD Z11, Z18, Z18
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 104(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z18, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 27
+	VPRORD $17, Z17, Z24
+	VPRORD $19, Z17, Z25
+	VPSRLD $10, Z17, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z20, Z25
+	VPRORD $18, Z20, Z26
+	VPSRLD $3, Z20, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z19, Z19
+	VPADDD Z25, Z19, Z19
+	VPADDD Z12, Z19, Z19
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 108(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z19, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 28
+	VPRORD $17, Z18, Z24
+	VPRORD $19, Z18, Z25
+	VPSRLD $10, Z18, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z21, Z25
+	VPRORD $18, Z21, Z26
+	VPSRLD $3, Z21, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z20, Z20
+	VPADDD Z25, Z20, Z20
+	VPADDD Z13, Z20, Z20
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 112(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z20, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 29
+	VPRORD $17, Z19, Z24
+	VPRORD $19, Z19, Z25
+	VPSRLD $10, Z19, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z22, Z25
+	VPRORD $18, Z22, Z26
+	VPSRLD $3, Z22, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z21, Z21
+	VPADDD Z25, Z21, Z21
+	VPADDD Z14, Z21, Z21
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 116(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z21, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 30
+	VPRORD $17, Z20, Z24
+	VPRORD $19, Z20, Z25
+	VPSRLD $10, Z20, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z23, Z25
+	VPRORD $18, Z23, Z26
+	VPSRLD $3, Z23, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z22, Z22
+	VPADDD Z25, Z22, Z22
+	VPADDD Z15, Z22, Z22
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 120(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z22, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 31
+	VPRORD $17, Z21, Z24
+	VPRORD $19, Z21, Z25
+	VPSRLD $10, Z21, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z8, Z25
+	VPRORD $18, Z8, Z26
+	VPSRLD $3, Z8, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z23, Z23
+	VPADDD Z25, Z23, Z23
+	VPADDD Z16, Z23, Z23
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 124(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z23, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// round 32
+	VPRORD $17, Z22, Z24
+	VPRORD $19, Z22, Z25
+	VPSRLD $10, Z22, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z9, Z25
+	VPRORD $18, Z9, Z26
+	VPSRLD $3, Z9, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z8, Z8
+	VPADDD Z25, Z8, Z8
+	VPADDD Z17, Z8, Z8
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 128(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z8, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 33
+	VPRORD $17, Z23, Z24
+	VPRORD $19, Z23, Z25
+	VPSRLD $10, Z23, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z10, Z25
+	VPRORD $18, Z10, Z26
+	VPSRLD $3, Z10, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z9, Z9
+	VPADDD Z25, Z9, Z9
+	VPADDD Z18, Z9, Z9
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 132(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z9, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 34
+	VPRORD $17, Z8, Z24
+	VPRORD $19, Z8, Z25
+	VPSRLD $10, Z8, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z11, Z25
+	VPRORD $18, Z11, Z26
+	VPSRLD $3, Z11, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z10, Z10
+	VPADDD Z25, Z10, Z10
+	VPADDD Z19, Z10, Z10
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 136(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z10, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 35
+	VPRORD $17, Z9, Z24
+	VPRORD $19, Z9, Z25
+	VPSRLD $10, Z9, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z12, Z25
+	VPRORD $18, Z12, Z26
+	VPSRLD $3, Z12, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z11, Z11
+	VPADDD Z25, Z11, Z11
+	VPADDD Z20, Z11, Z11
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 140(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z11, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 36
+	VPRORD $17, Z10, Z24
+	VPRORD $19, Z10, Z25
+	VPSRLD $10, Z10, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z13, Z25
+	VPRORD $18, Z13, Z26
+	VPSRLD $3, Z13, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z12, Z12
+	VPADDD Z25, Z12, Z12
+	VPADDD Z21, Z12, Z12
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 144(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z12, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 37
+	VPRORD $17, Z11, Z24
+	VPRORD $19, Z11, Z25
+	VPSRLD $10, Z11, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z14, Z25
+	VPRORD $18, Z14, Z26
+	VPSRLD $3, Z14, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z13, Z13
+	VPADDD Z25, Z13, Z13
+	VPADDD Z22, Z13, Z13
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 148(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z13, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 38
+	VPRORD $17, Z12, Z24
+	VPRORD $19, Z12, Z25
+	VPSRLD $10, Z12, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z15, Z25
+	VPRORD $18, Z15, Z26
+	VPSRLD $3, Z15, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z14, Z14
+	VPADDD Z25, Z14, Z14
+	VPADDD Z23, Z14, Z14
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 152(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z14, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 39
+	VPRORD $17, Z13, Z24
+	VPRORD $19, Z13, Z25
+	VPSRLD $10, Z13, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z16, Z25
+	VPRORD $18, Z16, Z26
+	VPSRLD $3, Z16, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z15, Z15
+	VPADDD Z25, Z15, Z15
+	VPADDD Z8, Z15, Z15
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 156(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z15, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// round 40
+	VPRORD $17, Z14, Z24
+	VPRORD $19, Z14, Z25
+	VPSRLD $10, Z14, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z17, Z25
+	VPRORD $18, Z17, Z26
+	VPSRLD $3, Z17, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z16, Z16
+	VPADDD Z25, Z16, Z16
+	VPADDD Z9, Z16, Z16
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 160(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z16, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 41
+	VPRORD $17, Z15, Z24
+	VPRORD $19, Z15, Z25
+	VPSRLD $10, Z15, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z18, Z25
+	VPRORD $18, Z18, Z26
+	VPSRLD $3, Z18, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z17, Z17
+	VPADDD Z25, Z17, Z17
+	VPADDD Z10, Z17, Z17
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 164(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z17, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 42
+	VPRORD $17, Z16, Z24
+	VPRORD $19, Z16, Z25
+	VPSRLD $10, Z16, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z19, Z25
+	VPRORD $18, Z19, Z26
+	VPSRLD $3, Z19, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z18, Z18
+	VPADDD Z25, Z18, Z18
+	VPADDD Z11, Z18, Z18
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 168(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z18, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 43
+	VPRORD $17, Z17, Z24
+	VPRORD $19, Z17, Z25
+	VPSRLD $10, Z17, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z20, Z25
+	VPRORD $18, Z20, Z26
+	VPSRLD $3, Z20, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z19, Z19
+	VPADDD Z25, Z19, Z19
+	VPADDD Z12, Z19, Z19
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 172(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z19, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 44
+	VPRORD $17, Z18, Z24
+	VPRORD $19, Z18, Z25
+	VPSRLD $10, Z18, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z21, Z25
+	VPRORD $18, Z21, Z26
+	VPSRLD $3, Z21, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z20, Z20
+	VPADDD Z25, Z20, Z20
+	VPADDD Z13, Z20, Z20
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 176(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z20, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 45
+	VPRORD $17, Z19, Z24
+	VPRORD $19, Z19, Z25
+	VPSRLD $10, Z19, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z22, Z25
+	VPRORD $18, Z22, Z26
+	VPSRLD $3, Z22, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z21, Z21
+	VPADDD Z25, Z21, Z21
+	VPADDD Z14, Z21, Z21
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 180(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z21, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 46
+	VPRORD $17, Z20, Z24
+	VPRORD $19, Z20, Z25
+	VPSRLD $10, Z20, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z23, Z25
+	VPRORD $18, Z23, Z26
+	VPSRLD $3, Z23, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z22, Z22
+	VPADDD Z25, Z22, Z22
+	VPADDD Z15, Z22, Z22
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 184(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z22, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 47
+	VPRORD $17, Z21, Z24
+	VPRORD $19, Z21, Z25
+	VPSRLD $10, Z21, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z8, Z25
+	VPRORD $18, Z8, Z26
+	VPSRLD $3, Z8, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z23, Z23
+	VPADDD Z25, Z23, Z23
+	VPADDD Z16, Z23, Z23
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 188(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z23, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// round 48
+	VPRORD $17, Z22, Z24
+	VPRORD $19, Z22, Z25
+	VPSRLD $10, Z22, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z9, Z25
+	VPRORD $18, Z9, Z26
+	VPSRLD $3, Z9, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z8, Z8
+	VPADDD Z25, Z8, Z8
+	VPADDD Z17, Z8, Z8
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 192(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z8, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 49
+	VPRORD $17, Z23, Z24
+	VPRORD $19, Z23, Z25
+	VPSRLD $10, Z23, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z10, Z25
+	VPRORD $18, Z10, Z26
+	VPSRLD $3, Z10, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z9, Z9
+	VPADDD Z25, Z9, Z9
+	VPADDD Z18, Z9, Z9
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 196(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z9, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 50
+	VPRORD $17, Z8, Z24
+	VPRORD $19, Z8, Z25
+	VPSRLD $10, Z8, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z11, Z25
+	VPRORD $18, Z11, Z26
+	VPSRLD $3, Z11, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z10, Z10
+	VPADDD Z25, Z10, Z10
+	VPADDD Z19, Z10, Z10
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 200(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z10, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 51
+	VPRORD $17, Z9, Z24
+	VPRORD $19, Z9, Z25
+	VPSRLD $10, Z9, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z12, Z25
+	VPRORD $18, Z12, Z26
+	VPSRLD $3, Z12, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z11, Z11
+	VPADDD Z25, Z11, Z11
+	VPADDD Z20, Z11, Z11
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 204(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z11, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 52
+	VPRORD $17, Z10, Z24
+	VPRORD $19, Z10, Z25
+	VPSRLD $10, Z10, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z13, Z25
+	VPRORD $18, Z13, Z26
+	VPSRLD $3, Z13, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z12, Z12
+	VPADDD Z25, Z12, Z12
+	VPADDD Z21, Z12, Z12
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 208(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z12, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 53
+	VPRORD $17, Z11, Z24
+	VPRORD $19, Z11, Z25
+	VPSRLD $10, Z11, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z14, Z25
+	VPRORD $18, Z14, Z26
+	VPSRLD $3, Z14, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z13, Z13
+	VPADDD Z25, Z13, Z13
+	VPADDD Z22, Z13, Z13
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 212(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z13, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 54
+	VPRORD $17, Z12, Z24
+	VPRORD $19, Z12, Z25
+	VPSRLD $10, Z12, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z15, Z25
+	VPRORD $18, Z15, Z26
+	VPSRLD $3, Z15, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z14, Z14
+	VPADDD Z25, Z14, Z14
+	VPADDD Z23, Z14, Z14
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 216(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z14, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 55
+	VPRORD $17, Z13, Z24
+	VPRORD $19, Z13, Z25
+	VPSRLD $10, Z13, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z16, Z25
+	VPRORD $18, Z16, Z26
+	VPSRLD $3, Z16, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z15, Z15
+	VPADDD Z25, Z15, Z15
+	VPADDD Z8, Z15, Z15
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 220(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z15, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// round 56
+	VPRORD $17, Z14, Z24
+	VPRORD $19, Z14, Z25
+	VPSRLD $10, Z14, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z17, Z25
+	VPRORD $18, Z17, Z26
+	VPSRLD $3, Z17, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z16, Z16
+	VPADDD Z25, Z16, Z16
+	VPADDD Z9, Z16, Z16
+	VPRORD $6, Z4, Z24
+	VPRORD $11, Z4, Z25
+	VPRORD $25, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xca, Z6, Z5, Z25
+	VPADDD Z25, Z7, Z7
+	VPBROADCASTD 224(BX), Z26
+	VPADDD Z26, Z7, Z7
+	VPADDD Z16, Z7, Z7
+	VPADDD Z7, Z3, Z3
+	VPRORD $2, Z0, Z24
+	VPRORD $13, Z0, Z25
+	VPRORD $22, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z7, Z7
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xe8, Z2, Z1, Z25
+	VPADDD Z25, Z7, Z7
+
+	// round 57
+	VPRORD $17, Z15, Z24
+	VPRORD $19, Z15, Z25
+	VPSRLD $10, Z15, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z18, Z25
+	VPRORD $18, Z18, Z26
+	VPSRLD $3, Z18, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z17, Z17
+	VPADDD Z25, Z17, Z17
+	VPADDD Z10, Z17, Z17
+	VPRORD $6, Z3, Z24
+	VPRORD $11, Z3, Z25
+	VPRORD $25, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xca, Z5, Z4, Z25
+	VPADDD Z25, Z6, Z6
+	VPBROADCASTD 228(BX), Z26
+	VPADDD Z26, Z6, Z6
+	VPADDD Z17, Z6, Z6
+	VPADDD Z6, Z2, Z2
+	VPRORD $2, Z7, Z24
+	VPRORD $13, Z7, Z25
+	VPRORD $22, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z6, Z6
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xe8, Z1, Z0, Z25
+	VPADDD Z25, Z6, Z6
+
+	// round 58
+	VPRORD $17, Z16, Z24
+	VPRORD $19, Z16, Z25
+	VPSRLD $10, Z16, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z19, Z25
+	VPRORD $18, Z19, Z26
+	VPSRLD $3, Z19, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z18, Z18
+	VPADDD Z25, Z18, Z18
+	VPADDD Z11, Z18, Z18
+	VPRORD $6, Z2, Z24
+	VPRORD $11, Z2, Z25
+	VPRORD $25, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xca, Z4, Z3, Z25
+	VPADDD Z25, Z5, Z5
+	VPBROADCASTD 232(BX), Z26
+	VPADDD Z26, Z5, Z5
+	VPADDD Z18, Z5, Z5
+	VPADDD Z5, Z1, Z1
+	VPRORD $2, Z6, Z24
+	VPRORD $13, Z6, Z25
+	VPRORD $22, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z5, Z5
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xe8, Z0, Z7, Z25
+	VPADDD Z25, Z5, Z5
+
+	// round 59
+	VPRORD $17, Z17, Z24
+	VPRORD $19, Z17, Z25
+	VPSRLD $10, Z17, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z20, Z25
+	VPRORD $18, Z20, Z26
+	VPSRLD $3, Z20, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z19, Z19
+	VPADDD Z25, Z19, Z19
+	VPADDD Z12, Z19, Z19
+	VPRORD $6, Z1, Z24
+	VPRORD $11, Z1, Z25
+	VPRORD $25, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xca, Z3, Z2, Z25
+	VPADDD Z25, Z4, Z4
+	VPBROADCASTD 236(BX), Z26
+	VPADDD Z26, Z4, Z4
+	VPADDD Z19, Z4, Z4
+	VPADDD Z4, Z0, Z0
+	VPRORD $2, Z5, Z24
+	VPRORD $13, Z5, Z25
+	VPRORD $22, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z4, Z4
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xe8, Z7, Z6, Z25
+	VPADDD Z25, Z4, Z4
+
+	// round 60
+	VPRORD $17, Z18, Z24
+	VPRORD $19, Z18, Z25
+	VPSRLD $10, Z18, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z21, Z25
+	VPRORD $18, Z21, Z26
+	VPSRLD $3, Z21, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z20, Z20
+	VPADDD Z25, Z20, Z20
+	VPADDD Z13, Z20, Z20
+	VPRORD $6, Z0, Z24
+	VPRORD $11, Z0, Z25
+	VPRORD $25, Z0, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGD $0xca, Z2, Z1, Z25
+	VPADDD Z25, Z3, Z3
+	VPBROADCASTD 240(BX), Z26
+	VPADDD Z26, Z3, Z3
+	VPADDD Z20, Z3, Z3
+	VPADDD Z3, Z7, Z7
+	VPRORD $2, Z4, Z24
+	VPRORD $13, Z4, Z25
+	VPRORD $22, Z4, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z3, Z3
+	VMOVDQA64 Z4, Z25
+	VPTERNLOGD $0xe8, Z6, Z5, Z25
+	VPADDD Z25, Z3, Z3
+
+	// round 61
+	VPRORD $17, Z19, Z24
+	VPRORD $19, Z19, Z25
+	VPSRLD $10, Z19, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z22, Z25
+	VPRORD $18, Z22, Z26
+	VPSRLD $3, Z22, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z21, Z21
+	VPADDD Z25, Z21, Z21
+	VPADDD Z14, Z21, Z21
+	VPRORD $6, Z7, Z24
+	VPRORD $11, Z7, Z25
+	VPRORD $25, Z7, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z7, Z25
+	VPTERNLOGD $0xca, Z1, Z0, Z25
+	VPADDD Z25, Z2, Z2
+	VPBROADCASTD 244(BX), Z26
+	VPADDD Z26, Z2, Z2
+	VPADDD Z21, Z2, Z2
+	VPADDD Z2, Z6, Z6
+	VPRORD $2, Z3, Z24
+	VPRORD $13, Z3, Z25
+	VPRORD $22, Z3, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z2, Z2
+	VMOVDQA64 Z3, Z25
+	VPTERNLOGD $0xe8, Z5, Z4, Z25
+	VPADDD Z25, Z2, Z2
+
+	// round 62
+	VPRORD $17, Z20, Z24
+	VPRORD $19, Z20, Z25
+	VPSRLD $10, Z20, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z23, Z25
+	VPRORD $18, Z23, Z26
+	VPSRLD $3, Z23, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z22, Z22
+	VPADDD Z25, Z22, Z22
+	VPADDD Z15, Z22, Z22
+	VPRORD $6, Z6, Z24
+	VPRORD $11, Z6, Z25
+	VPRORD $25, Z6, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z6, Z25
+	VPTERNLOGD $0xca, Z0, Z7, Z25
+	VPADDD Z25, Z1, Z1
+	VPBROADCASTD 248(BX), Z26
+	VPADDD Z26, Z1, Z1
+	VPADDD Z22, Z1, Z1
+	VPADDD Z1, Z5, Z5
+	VPRORD $2, Z2, Z24
+	VPRORD $13, Z2, Z25
+	VPRORD $22, Z2, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z1, Z1
+	VMOVDQA64 Z2, Z25
+	VPTERNLOGD $0xe8, Z4, Z3, Z25
+	VPADDD Z25, Z1, Z1
+
+	// round 63
+	VPRORD $17, Z21, Z24
+	VPRORD $19, Z21, Z25
+	VPSRLD $10, Z21, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPRORD $7, Z8, Z25
+	VPRORD $18, Z8, Z26
+	VPSRLD $3, Z8, Z27
+	VPTERNLOGD $0x96, Z27, Z26, Z25
+	VPADDD Z24, Z23, Z23
+	VPADDD Z25, Z23, Z23
+	VPADDD Z16, Z23, Z23
+	VPRORD $6, Z5, Z24
+	VPRORD $11, Z5, Z25
+	VPRORD $25, Z5, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z5, Z25
+	VPTERNLOGD $0xca, Z7, Z6, Z25
+	VPADDD Z25, Z0, Z0
+	VPBROADCASTD 252(BX), Z26
+	VPADDD Z26, Z0, Z0
+	VPADDD Z23, Z0, Z0
+	VPADDD Z0, Z4, Z4
+	VPRORD $2, Z1, Z24
+	VPRORD $13, Z1, Z25
+	VPRORD $22, Z1, Z26
+	VPTERNLOGD $0x96, Z26, Z25, Z24
+	VPADDD Z24, Z0, Z0
+	VMOVDQA64 Z1, Z25
+	VPTERNLOGD $0xe8, Z3, Z2, Z25
+	VPADDD Z25, Z0, Z0
+
+	// state += a..h
+	VPADDD 0(AX), Z0, Z0
+	VMOVDQU32 Z0, 0(AX)
+	VPADDD 64(AX), Z1, Z1
+	VMOVDQU32 Z1, 64(AX)
+	VPADDD 128(AX), Z2, Z2
+	VMOVDQU32 Z2, 128(AX)
+	VPADDD 192(AX), Z3, Z3
+	VMOVDQU32 Z3, 192(AX)
+	VPADDD 256(AX), Z4, Z4
+	VMOVDQU32 Z4, 256(AX)
+	VPADDD 320(AX), Z5, Z5
+	VMOVDQU32 Z5, 320(AX)
+	VPADDD 384(AX), Z6, Z6
+	VMOVDQU32 Z6, 384(AX)
+	VPADDD 448(AX), Z7, Z7
+	VMOVDQU32 Z7, 448(AX)
+	VZEROUPPER
+	RET
+
+// func keccakF1600AVX2(state *[25 * 4]uint64)
+TEXT ·keccakF1600AVX2(SB), 0, $800-8
+	MOVQ state+0(FP), AX
+	LEAQ keccakRC<>(SB), BX
+	MOVQ $24, CX
+
+round:
+
+	// θ: C[x] in Y0..Y4, D[x] in Y5..Y9
+	VMOVDQU 0(AX), Y0
+	VPXOR 160(AX), Y0, Y0
+	VPXOR 320(AX), Y0, Y0
+	VPXOR 480(AX), Y0, Y0
+	VPXOR 640(AX), Y0, Y0
+	VMOVDQU 32(AX), Y1
+	VPXOR 192(AX), Y1, Y1
+	VPXOR 352(AX), Y1, Y1
+	VPXOR 512(AX), Y1, Y1
+	VPXOR 672(AX), Y1, Y1
+	VMOVDQU 64(AX), Y2
+	VPXOR 224(AX), Y2, Y2
+	VPXOR 384(AX), Y2, Y2
+	VPXOR 544(AX), Y2, Y2
+	VPXOR 704(AX), Y2, Y2
+	VMOVDQU 96(AX), Y3
+	VPXOR 256(AX), Y3, Y3
+	VPXOR 416(AX), Y3, Y3
+	VPXOR 576(AX), Y3, Y3
+	VPXOR 736(AX), Y3, Y3
+	VMOVDQU 128(AX), Y4
+	VPXOR 288(AX), Y4, Y4
+	VPXOR 448(AX), Y4, Y4
+	VPXOR 608(AX), Y4, Y4
+	VPXOR 768(AX), Y4, Y4
+	VPSLLQ $1, Y1, Y5
+	VPSRLQ $63, Y1, Y10
+	VPOR Y10, Y5, Y5
+	VPXOR Y4, Y5, Y5
+	VPSLLQ $1, Y2, Y6
+	VPSRLQ $63, Y2, Y10
+	VPOR Y10, Y6, Y6
+	VPXOR Y0, Y6, Y6
+	VPSLLQ $1, Y3, Y7
+	VPSRLQ $63, Y3, Y10
+	VPOR Y10, Y7, Y7
+	VPXOR Y1, Y7, Y7
+	VPSLLQ $1, Y4, Y8
+	VPSRLQ $63, Y4, Y10
+	VPOR Y10, Y8, Y8
+	VPXOR Y2, Y8, Y8
+	VPSLLQ $1, Y0, Y9
+	VPSRLQ $63, Y0, Y10
+	VPOR Y10, Y9, Y9
+	VPXOR Y3, Y9, Y9
+
+	// ρ and π into B on the stack
+	VMOVDQU 0(AX), Y10
+	VPXOR Y5, Y10, Y10
+	VMOVDQU Y10, 0(SP)
+	VMOVDQU 32(AX), Y10
+	VPXOR Y6, Y10, Y10
+	VPSLLQ $1, Y10, Y11
+	VPSRLQ $63, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 320(SP)
+	VMOVDQU 64(AX), Y10
+	VPXOR Y7, Y10, Y10
+	VPSLLQ $62, Y10, Y11
+	VPSRLQ $2, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 640(SP)
+	VMOVDQU 96(AX), Y10
+	VPXOR Y8, Y10, Y10
+	VPSLLQ $28, Y10, Y11
+	VPSRLQ $36, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 160(SP)
+	VMOVDQU 128(AX), Y10
+	VPXOR Y9, Y10, Y10
+	VPSLLQ $27, Y10, Y11
+	VPSRLQ $37, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 480(SP)
+	VMOVDQU 160(AX), Y10
+	VPXOR Y5, Y10, Y10
+	VPSLLQ $36, Y10, Y11
+	VPSRLQ $28, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 512(SP)
+	VMOVDQU 192(AX), Y10
+	VPXOR Y6, Y10, Y10
+	VPSLLQ $44, Y10, Y11
+	VPSRLQ $20, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 32(SP)
+	VMOVDQU 224(AX), Y10
+	VPXOR Y7, Y10, Y10
+	VPSLLQ $6, Y10, Y11
+	VPSRLQ $58, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 352(SP)
+	VMOVDQU 256(AX), Y10
+	VPXOR Y8, Y10, Y10
+	VPSLLQ $55, Y10, Y11
+	VPSRLQ $9, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 672(SP)
+	VMOVDQU 288(AX), Y10
+	VPXOR Y9, Y10, Y10
+	VPSLLQ $20, Y10, Y11
+	VPSRLQ $44, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 192(SP)
+	VMOVDQU 320(AX), Y10
+	VPXOR Y5, Y10, Y10
+	VPSLLQ $3, Y10, Y11
+	VPSRLQ $61, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 224(SP)
+	VMOVDQU 352(AX), Y10
+	VPXOR Y6, Y10, Y10
+	VPSLLQ $10, Y10, Y11
+	VPSRLQ $54, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 544(SP)
+	VMOVDQU 384(AX), Y10
+	VPXOR Y7, Y10, Y10
+	VPSLLQ $43, Y10, Y11
+	VPSRLQ $21, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 64(SP)
+	VMOVDQU 416(AX), Y10
+	VPXOR Y8, Y10, Y10
+	VPSLLQ $25, Y10, Y11
+	VPSRLQ $39, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 384(SP)
+	VMOVDQU 448(AX), Y10
+	VPXOR Y9, Y10, Y10
+	VPSLLQ $39, Y10, Y11
+	VPSRLQ $25, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 704(SP)
+	VMOVDQU 480(AX), Y10
+	VPXOR Y5, Y10, Y10
+	VPSLLQ $41, Y10, Y11
+	VPSRLQ $23, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 736(SP)
+	VMOVDQU 512(AX), Y10
+	VPXOR Y6, Y10, Y10
+	VPSLLQ $45, Y10, Y11
+	VPSRLQ $19, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 256(SP)
+	VMOVDQU 544(AX), Y10
+	VPXOR Y7, Y10, Y10
+	VPSLLQ $15, Y10, Y11
+	VPSRLQ $49, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 576(SP)
+	VMOVDQU 576(AX), Y10
+	VPXOR Y8, Y10, Y10
+	VPSLLQ $21, Y10, Y11
+	VPSRLQ $43, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 96(SP)
+	VMOVDQU 608(AX), Y10
+	VPXOR Y9, Y10, Y10
+	VPSLLQ $8, Y10, Y11
+	VPSRLQ $56, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 416(SP)
+	VMOVDQU 640(AX), Y10
+	VPXOR Y5, Y10, Y10
+	VPSLLQ $18, Y10, Y11
+	VPSRLQ $46, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 448(SP)
+	VMOVDQU 672(AX), Y10
+	VPXOR Y6, Y10, Y10
+	VPSLLQ $2, Y10, Y11
+	VPSRLQ $62, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 768(SP)
+	VMOVDQU 704(AX), Y10
+	VPXOR Y7, Y10, Y10
+	VPSLLQ $61, Y10, Y11
+	VPSRLQ $3, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 288(SP)
+	VMOVDQU 736(AX), Y10
+	VPXOR Y8, Y10, Y10
+	VPSLLQ $56, Y10, Y11
+	VPSRLQ $8, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 608(SP)
+	VMOVDQU 768(AX), Y10
+	VPXOR Y9, Y10, Y10
+	VPSLLQ $14, Y10, Y11
+	VPSRLQ $50, Y10, Y10
+	VPOR Y11, Y10, Y10
+	VMOVDQU Y10, 128(SP)
+
+	// χ and ι back into the state
+	VPBROADCASTQ (BX), Y5
+	VMOVDQU 0(SP), Y10
+	VMOVDQU 32(SP), Y11
+	VMOVDQU 64(SP), Y12
+	VMOVDQU 96(SP), Y13
+	VMOVDQU 128(SP), Y14
+	VPANDN Y12, Y11, Y15
+	VPXOR Y10, Y15, Y15
+	VPXOR Y5, Y15, Y15
+	VMOVDQU Y15, 0(AX)
+	VPANDN Y13, Y12, Y15
+	VPXOR Y11, Y15, Y15
+	VMOVDQU Y15, 32(AX)
+	VPANDN Y14, Y13, Y15
+	VPXOR Y12, Y15, Y15
+	VMOVDQU Y15, 64(AX)
+	VPANDN Y10, Y14, Y15
+	VPXOR Y13, Y15, Y15
+	VMOVDQU Y15, 96(AX)
+	VPANDN Y11, Y10, Y15
+	VPXOR Y14, Y15, Y15
+	VMOVDQU Y15, 128(AX)
+	VMOVDQU 160(SP), Y10
+	VMOVDQU 192(SP), Y11
+	VMOVDQU 224(SP), Y12
+	VMOVDQU 256(SP), Y13
+	VMOVDQU 288(SP), Y14
+	VPANDN Y12, Y11, Y15
+	VPXOR Y10, Y15, Y15
+	VMOVDQU Y15, 160(AX)
+	VPANDN Y13, Y12, Y15
+	VPXOR Y11, Y15, Y15
+	VMOVDQU Y15, 192(AX)
+	VPANDN Y14, Y13, Y15
+	VPXOR Y12, Y15, Y15
+	VMOVDQU Y15, 224(AX)
+	VPANDN Y10, Y14, Y15
+	VPXOR Y13, Y15, Y15
+	VMOVDQU Y15, 256(AX)
+	VPANDN Y11, Y10, Y15
+	VPXOR Y14, Y15, Y15
+	VMOVDQU Y15, 288(AX)
+	VMOVDQU 320(SP), Y10
+	VMOVDQU 352(SP), Y11
+	VMOVDQU 384(SP), Y12
+	VMOVDQU 416(SP), Y13
+	VMOVDQU 448(SP), Y14
+	VPANDN Y12, Y11, Y15
+	VPXOR Y10, Y15, Y15
+	VMOVDQU Y15, 320(AX)
+	VPANDN Y13, Y12, Y15
+	VPXOR Y11, Y15, Y15
+	VMOVDQU Y15, 352(AX)
+	VPANDN Y14, Y13, Y15
+	VPXOR Y12, Y15, Y15
+	VMOVDQU Y15, 384(AX)
+	VPANDN Y10, Y14, Y15
+	VPXOR Y13, Y15, Y15
+	VMOVDQU Y15, 416(AX)
+	VPANDN Y11, Y10, Y15
+	VPXOR Y14, Y15, Y15
+	VMOVDQU Y15, 448(AX)
+	VMOVDQU 480(SP), Y10
+	VMOVDQU 512(SP), Y11
+	VMOVDQU 544(SP), Y12
+	VMOVDQU 576(SP), Y13
+	VMOVDQU 608(SP), Y14
+	VPANDN Y12, Y11, Y15
+	VPXOR Y10, Y15, Y15
+	VMOVDQU Y15, 480(AX)
+	VPANDN Y13, Y12, Y15
+	VPXOR Y11, Y15, Y15
+	VMOVDQU Y15, 512(AX)
+	VPANDN Y14, Y13, Y15
+	VPXOR Y12, Y15, Y15
+	VMOVDQU Y15, 544(AX)
+	VPANDN Y10, Y14, Y15
+	VPXOR Y13, Y15, Y15
+	VMOVDQU Y15, 576(AX)
+	VPANDN Y11, Y10, Y15
+	VPXOR Y14, Y15, Y15
+	VMOVDQU Y15, 608(AX)
+	VMOVDQU 640(SP), Y10
+	VMOVDQU 672(SP), Y11
+	VMOVDQU 704(SP), Y12
+	VMOVDQU 736(SP), Y13
+	VMOVDQU 768(SP), Y14
+	VPANDN Y12, Y11, Y15
+	VPXOR Y10, Y15, Y15
+	VMOVDQU Y15, 640(AX)
+	VPANDN Y13, Y12, Y15
+	VPXOR Y11, Y15, Y15
+	VMOVDQU Y15, 672(AX)
+	VPANDN Y14, Y13, Y15
+	VPXOR Y12, Y15, Y15
+	VMOVDQU Y15, 704(AX)
+	VPANDN Y10, Y14, Y15
+	VPXOR Y13, Y15, Y15
+	VMOVDQU Y15, 736(AX)
+	VPANDN Y11, Y10, Y15
+	VPXOR Y14, Y15, Y15
+	VMOVDQU Y15, 768(AX)
+	ADDQ $8, BX
+	DECQ CX
+	JNZ round
+	VZEROUPPER
+	RET
+
+// func keccakF1600AVX512(state *[25 * 8]uint64)
+TEXT ·keccakF1600AVX512(SB), NOSPLIT, $0-8
+	MOVQ state+0(FP), AX
+	LEAQ keccakRC<>(SB), BX
+	VMOVDQU64 0(AX), Z0
+	VMOVDQU64 64(AX), Z1
+	VMOVDQU64 128(AX), Z2
+	VMOVDQU64 192(AX), Z3
+	VMOVDQU64 256(AX), Z4
+	VMOVDQU64 320(AX), Z5
+	VMOVDQU64 384(AX), Z6
+	VMOVDQU64 448(AX), Z7
+	VMOVDQU64 512(AX), Z8
+	VMOVDQU64 576(AX), Z9
+	VMOVDQU64 640(AX), Z10
+	VMOVDQU64 704(AX), Z11
+	VMOVDQU64 768(AX), Z12
+	VMOVDQU64 832(AX), Z13
+	VMOVDQU64 896(AX), Z14
+	VMOVDQU64 960(AX), Z15
+	VMOVDQU64 1024(AX), Z16
+	VMOVDQU64 1088(AX), Z17
+	VMOVDQU64 1152(AX), Z18
+	VMOVDQU64 1216(AX), Z19
+	VMOVDQU64 1280(AX), Z20
+	VMOVDQU64 1344(AX), Z21
+	VMOVDQU64 1408(AX), Z22
+	VMOVDQU64 1472(AX), Z23
+	VMOVDQU64 1536(AX), Z24
+
+	// round 0: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z10, Z5, Z25
+	VPTERNLOGQ $0x96, Z20, Z15, Z25
+	VMOVDQA64 Z1, Z26
+	VPTERNLOGQ $0x96, Z11, Z6, Z26
+	VPTERNLOGQ $0x96, Z21, Z16, Z26
+	VMOVDQA64 Z2, Z27
+	VPTERNLOGQ $0x96, Z12, Z7, Z27
+	VPTERNLOGQ $0x96, Z22, Z17, Z27
+	VMOVDQA64 Z3, Z28
+	VPTERNLOGQ $0x96, Z13, Z8, Z28
+	VPTERNLOGQ $0x96, Z23, Z18, Z28
+	VMOVDQA64 Z4, Z29
+	VPTERNLOGQ $0x96, Z14, Z9, Z29
+	VPTERNLOGQ $0x96, Z24, Z19, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z20, Z20
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z21, Z21
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z22, Z22
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z23, Z23
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z24, Z24
+
+	// round 0: ρ, π
+	VPROLQ $1, Z1, Z1
+	VPROLQ $62, Z2, Z2
+	VPROLQ $28, Z3, Z3
+	VPROLQ $27, Z4, Z4
+	VPROLQ $36, Z5, Z5
+	VPROLQ $44, Z6, Z6
+	VPROLQ $6, Z7, Z7
+	VPROLQ $55, Z8, Z8
+	VPROLQ $20, Z9, Z9
+	VPROLQ $3, Z10, Z10
+	VPROLQ $10, Z11, Z11
+	VPROLQ $43, Z12, Z12
+	VPROLQ $25, Z13, Z13
+	VPROLQ $39, Z14, Z14
+	VPROLQ $41, Z15, Z15
+	VPROLQ $45, Z16, Z16
+	VPROLQ $15, Z17, Z17
+	VPROLQ $21, Z18, Z18
+	VPROLQ $8, Z19, Z19
+	VPROLQ $18, Z20, Z20
+	VPROLQ $2, Z21, Z21
+	VPROLQ $61, Z22, Z22
+	VPROLQ $56, Z23, Z23
+	VPROLQ $14, Z24, Z24
+
+	// round 0: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z6, Z26
+	VPTERNLOGQ $0xd2, Z12, Z6, Z0
+	VPTERNLOGQ $0xd2, Z18, Z12, Z6
+	VPTERNLOGQ $0xd2, Z24, Z18, Z12
+	VPTERNLOGQ $0xd2, Z25, Z24, Z18
+	VPTERNLOGQ $0xd2, Z26, Z25, Z24
+	VMOVDQA64 Z3, Z25
+	VMOVDQA64 Z9, Z26
+	VPTERNLOGQ $0xd2, Z10, Z9, Z3
+	VPTERNLOGQ $0xd2, Z16, Z10, Z9
+	VPTERNLOGQ $0xd2, Z22, Z16, Z10
+	VPTERNLOGQ $0xd2, Z25, Z22, Z16
+	VPTERNLOGQ $0xd2, Z26, Z25, Z22
+	VMOVDQA64 Z1, Z25
+	VMOVDQA64 Z7, Z26
+	VPTERNLOGQ $0xd2, Z13, Z7, Z1
+	VPTERNLOGQ $0xd2, Z19, Z13, Z7
+	VPTERNLOGQ $0xd2, Z20, Z19, Z13
+	VPTERNLOGQ $0xd2, Z25, Z20, Z19
+	VPTERNLOGQ $0xd2, Z26, Z25, Z20
+	VMOVDQA64 Z4, Z25
+	VMOVDQA64 Z5, Z26
+	VPTERNLOGQ $0xd2, Z11, Z5, Z4
+	VPTERNLOGQ $0xd2, Z17, Z11, Z5
+	VPTERNLOGQ $0xd2, Z23, Z17, Z11
+	VPTERNLOGQ $0xd2, Z25, Z23, Z17
+	VPTERNLOGQ $0xd2, Z26, Z25, Z23
+	VMOVDQA64 Z2, Z25
+	VMOVDQA64 Z8, Z26
+	VPTERNLOGQ $0xd2, Z14, Z8, Z2
+	VPTERNLOGQ $0xd2, Z15, Z14, Z8
+	VPTERNLOGQ $0xd2, Z21, Z15, Z14
+	VPTERNLOGQ $0xd2, Z25, Z21, Z15
+	VPTERNLOGQ $0xd2, Z26, Z25, Z21
+	VPBROADCASTQ 0(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 1: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z1, Z3, Z25
+	VPTERNLOGQ $0x96, Z2, Z4, Z25
+	VMOVDQA64 Z6, Z26
+	VPTERNLOGQ $0x96, Z7, Z9, Z26
+	VPTERNLOGQ $0x96, Z8, Z5, Z26
+	VMOVDQA64 Z12, Z27
+	VPTERNLOGQ $0x96, Z13, Z10, Z27
+	VPTERNLOGQ $0x96, Z14, Z11, Z27
+	VMOVDQA64 Z18, Z28
+	VPTERNLOGQ $0x96, Z19, Z16, Z28
+	VPTERNLOGQ $0x96, Z15, Z17, Z28
+	VMOVDQA64 Z24, Z29
+	VPTERNLOGQ $0x96, Z20, Z22, Z29
+	VPTERNLOGQ $0x96, Z21, Z23, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z2, Z2
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z8, Z8
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z14, Z14
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z15, Z15
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z21, Z21
+
+	// round 1: ρ, π
+	VPROLQ $1, Z6, Z6
+	VPROLQ $62, Z12, Z12
+	VPROLQ $28, Z18, Z18
+	VPROLQ $27, Z24, Z24
+	VPROLQ $36, Z3, Z3
+	VPROLQ $44, Z9, Z9
+	VPROLQ $6, Z10, Z10
+	VPROLQ $55, Z16, Z16
+	VPROLQ $20, Z22, Z22
+	VPROLQ $3, Z1, Z1
+	VPROLQ $10, Z7, Z7
+	VPROLQ $43, Z13, Z13
+	VPROLQ $25, Z19, Z19
+	VPROLQ $39, Z20, Z20
+	VPROLQ $41, Z4, Z4
+	VPROLQ $45, Z5, Z5
+	VPROLQ $15, Z11, Z11
+	VPROLQ $21, Z17, Z17
+	VPROLQ $8, Z23, Z23
+	VPROLQ $18, Z2, Z2
+	VPROLQ $2, Z8, Z8
+	VPROLQ $61, Z14, Z14
+	VPROLQ $56, Z15, Z15
+	VPROLQ $14, Z21, Z21
+
+	// round 1: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z9, Z26
+	VPTERNLOGQ $0xd2, Z13, Z9, Z0
+	VPTERNLOGQ $0xd2, Z17, Z13, Z9
+	VPTERNLOGQ $0xd2, Z21, Z17, Z13
+	VPTERNLOGQ $0xd2, Z25, Z21, Z17
+	VPTERNLOGQ $0xd2, Z26, Z25, Z21
+	VMOVDQA64 Z18, Z25
+	VMOVDQA64 Z22, Z26
+	VPTERNLOGQ $0xd2, Z1, Z22, Z18
+	VPTERNLOGQ $0xd2, Z5, Z1, Z22
+	VPTERNLOGQ $0xd2, Z14, Z5, Z1
+	VPTERNLOGQ $0xd2, Z25, Z14, Z5
+	VPTERNLOGQ $0xd2, Z26, Z25, Z14
+	VMOVDQA64 Z6, Z25
+	VMOVDQA64 Z10, Z26
+	VPTERNLOGQ $0xd2, Z19, Z10, Z6
+	VPTERNLOGQ $0xd2, Z23, Z19, Z10
+	VPTERNLOGQ $0xd2, Z2, Z23, Z19
+	VPTERNLOGQ $0xd2, Z25, Z2, Z23
+	VPTERNLOGQ $0xd2, Z26, Z25, Z2
+	VMOVDQA64 Z24, Z25
+	VMOVDQA64 Z3, Z26
+	VPTERNLOGQ $0xd2, Z7, Z3, Z24
+	VPTERNLOGQ $0xd2, Z11, Z7, Z3
+	VPTERNLOGQ $0xd2, Z15, Z11, Z7
+	VPTERNLOGQ $0xd2, Z25, Z15, Z11
+	VPTERNLOGQ $0xd2, Z26, Z25, Z15
+	VMOVDQA64 Z12, Z25
+	VMOVDQA64 Z16, Z26
+	VPTERNLOGQ $0xd2, Z20, Z16, Z12
+	VPTERNLOGQ $0xd2, Z4, Z20, Z16
+	VPTERNLOGQ $0xd2, Z8, Z4, Z20
+	VPTERNLOGQ $0xd2, Z25, Z8, Z4
+	VPTERNLOGQ $0xd2, Z26, Z25, Z8
+	VPBROADCASTQ 8(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 2: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z6, Z18, Z25
+	VPTERNLOGQ $0x96, Z12, Z24, Z25
+	VMOVDQA64 Z9, Z26
+	VPTERNLOGQ $0x96, Z10, Z22, Z26
+	VPTERNLOGQ $0x96, Z16, Z3, Z26
+	VMOVDQA64 Z13, Z27
+	VPTERNLOGQ $0x96, Z19, Z1, Z27
+	VPTERNLOGQ $0x96, Z20, Z7, Z27
+	VMOVDQA64 Z17, Z28
+	VPTERNLOGQ $0x96, Z23, Z5, Z28
+	VPTERNLOGQ $0x96, Z4, Z11, Z28
+	VMOVDQA64 Z21, Z29
+	VPTERNLOGQ $0x96, Z2, Z14, Z29
+	VPTERNLOGQ $0x96, Z8, Z15, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z12, Z12
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z16, Z16
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z20, Z20
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z4, Z4
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z8, Z8
+
+	// round 2: ρ, π
+	VPROLQ $1, Z9, Z9
+	VPROLQ $62, Z13, Z13
+	VPROLQ $28, Z17, Z17
+	VPROLQ $27, Z21, Z21
+	VPROLQ $36, Z18, Z18
+	VPROLQ $44, Z22, Z22
+	VPROLQ $6, Z1, Z1
+	VPROLQ $55, Z5, Z5
+	VPROLQ $20, Z14, Z14
+	VPROLQ $3, Z6, Z6
+	VPROLQ $10, Z10, Z10
+	VPROLQ $43, Z19, Z19
+	VPROLQ $25, Z23, Z23
+	VPROLQ $39, Z2, Z2
+	VPROLQ $41, Z24, Z24
+	VPROLQ $45, Z3, Z3
+	VPROLQ $15, Z7, Z7
+	VPROLQ $21, Z11, Z11
+	VPROLQ $8, Z15, Z15
+	VPROLQ $18, Z12, Z12
+	VPROLQ $2, Z16, Z16
+	VPROLQ $61, Z20, Z20
+	VPROLQ $56, Z4, Z4
+	VPROLQ $14, Z8, Z8
+
+	// round 2: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z22, Z26
+	VPTERNLOGQ $0xd2, Z19, Z22, Z0
+	VPTERNLOGQ $0xd2, Z11, Z19, Z22
+	VPTERNLOGQ $0xd2, Z8, Z11, Z19
+	VPTERNLOGQ $0xd2, Z25, Z8, Z11
+	VPTERNLOGQ $0xd2, Z26, Z25, Z8
+	VMOVDQA64 Z17, Z25
+	VMOVDQA64 Z14, Z26
+	VPTERNLOGQ $0xd2, Z6, Z14, Z17
+	VPTERNLOGQ $0xd2, Z3, Z6, Z14
+	VPTERNLOGQ $0xd2, Z20, Z3, Z6
+	VPTERNLOGQ $0xd2, Z25, Z20, Z3
+	VPTERNLOGQ $0xd2, Z26, Z25, Z20
+	VMOVDQA64 Z9, Z25
+	VMOVDQA64 Z1, Z26
+	VPTERNLOGQ $0xd2, Z23, Z1, Z9
+	VPTERNLOGQ $0xd2, Z15, Z23, Z1
+	VPTERNLOGQ $0xd2, Z12, Z15, Z23
+	VPTERNLOGQ $0xd2, Z25, Z12, Z15
+	VPTERNLOGQ $0xd2, Z26, Z25, Z12
+	VMOVDQA64 Z21, Z25
+	VMOVDQA64 Z18, Z26
+	VPTERNLOGQ $0xd2, Z10, Z18, Z21
+	VPTERNLOGQ $0xd2, Z7, Z10, Z18
+	VPTERNLOGQ $0xd2, Z4, Z7, Z10
+	VPTERNLOGQ $0xd2, Z25, Z4, Z7
+	VPTERNLOGQ $0xd2, Z26, Z25, Z4
+	VMOVDQA64 Z13, Z25
+	VMOVDQA64 Z5, Z26
+	VPTERNLOGQ $0xd2, Z2, Z5, Z13
+	VPTERNLOGQ $0xd2, Z24, Z2, Z5
+	VPTERNLOGQ $0xd2, Z16, Z24, Z2
+	VPTERNLOGQ $0xd2, Z25, Z16, Z24
+	VPTERNLOGQ $0xd2, Z26, Z25, Z16
+	VPBROADCASTQ 16(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 3: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z9, Z17, Z25
+	VPTERNLOGQ $0x96, Z13, Z21, Z25
+	VMOVDQA64 Z22, Z26
+	VPTERNLOGQ $0x96, Z1, Z14, Z26
+	VPTERNLOGQ $0x96, Z5, Z18, Z26
+	VMOVDQA64 Z19, Z27
+	VPTERNLOGQ $0x96, Z23, Z6, Z27
+	VPTERNLOGQ $0x96, Z2, Z10, Z27
+	VMOVDQA64 Z11, Z28
+	VPTERNLOGQ $0x96, Z15, Z3, Z28
+	VPTERNLOGQ $0x96, Z24, Z7, Z28
+	VMOVDQA64 Z8, Z29
+	VPTERNLOGQ $0x96, Z12, Z20, Z29
+	VPTERNLOGQ $0x96, Z16, Z4, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z13, Z13
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z5, Z5
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z2, Z2
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z24, Z24
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z16, Z16
+
+	// round 3: ρ, π
+	VPROLQ $1, Z22, Z22
+	VPROLQ $62, Z19, Z19
+	VPROLQ $28, Z11, Z11
+	VPROLQ $27, Z8, Z8
+	VPROLQ $36, Z17, Z17
+	VPROLQ $44, Z14, Z14
+	VPROLQ $6, Z6, Z6
+	VPROLQ $55, Z3, Z3
+	VPROLQ $20, Z20, Z20
+	VPROLQ $3, Z9, Z9
+	VPROLQ $10, Z1, Z1
+	VPROLQ $43, Z23, Z23
+	VPROLQ $25, Z15, Z15
+	VPROLQ $39, Z12, Z12
+	VPROLQ $41, Z21, Z21
+	VPROLQ $45, Z18, Z18
+	VPROLQ $15, Z10, Z10
+	VPROLQ $21, Z7, Z7
+	VPROLQ $8, Z4, Z4
+	VPROLQ $18, Z13, Z13
+	VPROLQ $2, Z5, Z5
+	VPROLQ $61, Z2, Z2
+	VPROLQ $56, Z24, Z24
+	VPROLQ $14, Z16, Z16
+
+	// round 3: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z14, Z26
+	VPTERNLOGQ $0xd2, Z23, Z14, Z0
+	VPTERNLOGQ $0xd2, Z7, Z23, Z14
+	VPTERNLOGQ $0xd2, Z16, Z7, Z23
+	VPTERNLOGQ $0xd2, Z25, Z16, Z7
+	VPTERNLOGQ $0xd2, Z26, Z25, Z16
+	VMOVDQA64 Z11, Z25
+	VMOVDQA64 Z20, Z26
+	VPTERNLOGQ $0xd2, Z9, Z20, Z11
+	VPTERNLOGQ $0xd2, Z18, Z9, Z20
+	VPTERNLOGQ $0xd2, Z2, Z18, Z9
+	VPTERNLOGQ $0xd2, Z25, Z2, Z18
+	VPTERNLOGQ $0xd2, Z26, Z25, Z2
+	VMOVDQA64 Z22, Z25
+	VMOVDQA64 Z6, Z26
+	VPTERNLOGQ $0xd2, Z15, Z6, Z22
+	VPTERNLOGQ $0xd2, Z4, Z15, Z6
+	VPTERNLOGQ $0xd2, Z13, Z4, Z15
+	VPTERNLOGQ $0xd2, Z25, Z13, Z4
+	VPTERNLOGQ $0xd2, Z26, Z25, Z13
+	VMOVDQA64 Z8, Z25
+	VMOVDQA64 Z17, Z26
+	VPTERNLOGQ $0xd2, Z1, Z17, Z8
+	VPTERNLOGQ $0xd2, Z10, Z1, Z17
+	VPTERNLOGQ $0xd2, Z24, Z10, Z1
+	VPTERNLOGQ $0xd2, Z25, Z24, Z10
+	VPTERNLOGQ $0xd2, Z26, Z25, Z24
+	VMOVDQA64 Z19, Z25
+	VMOVDQA64 Z3, Z26
+	VPTERNLOGQ $0xd2, Z12, Z3, Z19
+	VPTERNLOGQ $0xd2, Z21, Z12, Z3
+	VPTERNLOGQ $0xd2, Z5, Z21, Z12
+	VPTERNLOGQ $0xd2, Z25, Z5, Z21
+	VPTERNLOGQ $0xd2, Z26, Z25, Z5
+	VPBROADCASTQ 24(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 4: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z22, Z11, Z25
+	VPTERNLOGQ $0x96, Z19, Z8, Z25
+	VMOVDQA64 Z14, Z26
+	VPTERNLOGQ $0x96, Z6, Z20, Z26
+	VPTERNLOGQ $0x96, Z3, Z17, Z26
+	VMOVDQA64 Z23, Z27
+	VPTERNLOGQ $0x96, Z15, Z9, Z27
+	VPTERNLOGQ $0x96, Z12, Z1, Z27
+	VMOVDQA64 Z7, Z28
+	VPTERNLOGQ $0x96, Z4, Z18, Z28
+	VPTERNLOGQ $0x96, Z21, Z10, Z28
+	VMOVDQA64 Z16, Z29
+	VPTERNLOGQ $0x96, Z13, Z2, Z29
+	VPTERNLOGQ $0x96, Z5, Z24, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z19, Z19
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z3, Z3
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z12, Z12
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z21, Z21
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z5, Z5
+
+	// round 4: ρ, π
+	VPROLQ $1, Z14, Z14
+	VPROLQ $62, Z23, Z23
+	VPROLQ $28, Z7, Z7
+	VPROLQ $27, Z16, Z16
+	VPROLQ $36, Z11, Z11
+	VPROLQ $44, Z20, Z20
+	VPROLQ $6, Z9, Z9
+	VPROLQ $55, Z18, Z18
+	VPROLQ $20, Z2, Z2
+	VPROLQ $3, Z22, Z22
+	VPROLQ $10, Z6, Z6
+	VPROLQ $43, Z15, Z15
+	VPROLQ $25, Z4, Z4
+	VPROLQ $39, Z13, Z13
+	VPROLQ $41, Z8, Z8
+	VPROLQ $45, Z17, Z17
+	VPROLQ $15, Z1, Z1
+	VPROLQ $21, Z10, Z10
+	VPROLQ $8, Z24, Z24
+	VPROLQ $18, Z19, Z19
+	VPROLQ $2, Z3, Z3
+	VPROLQ $61, Z12, Z12
+	VPROLQ $56, Z21, Z21
+	VPROLQ $14, Z5, Z5
+
+	// round 4: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z20, Z26
+	VPTERNLOGQ $0xd2, Z15, Z20, Z0
+	VPTERNLOGQ $0xd2, Z10, Z15, Z20
+	VPTERNLOGQ $0xd2, Z5, Z10, Z15
+	VPTERNLOGQ $0xd2, Z25, Z5, Z10
+	VPTERNLOGQ $0xd2, Z26, Z25, Z5
+	VMOVDQA64 Z7, Z25
+	VMOVDQA64 Z2, Z26
+	VPTERNLOGQ $0xd2, Z22, Z2, Z7
+	VPTERNLOGQ $0xd2, Z17, Z22, Z2
+	VPTERNLOGQ $0xd2, Z12, Z17, Z22
+	VPTERNLOGQ $0xd2, Z25, Z12, Z17
+	VPTERNLOGQ $0xd2, Z26, Z25, Z12
+	VMOVDQA64 Z14, Z25
+	VMOVDQA64 Z9, Z26
+	VPTERNLOGQ $0xd2, Z4, Z9, Z14
+	VPTERNLOGQ $0xd2, Z24, Z4, Z9
+	VPTERNLOGQ $0xd2, Z19, Z24, Z4
+	VPTERNLOGQ $0xd2, Z25, Z19, Z24
+	VPTERNLOGQ $0xd2, Z26, Z25, Z19
+	VMOVDQA64 Z16, Z25
+	VMOVDQA64 Z11, Z26
+	VPTERNLOGQ $0xd2, Z6, Z11, Z16
+	VPTERNLOGQ $0xd2, Z1, Z6, Z11
+	VPTERNLOGQ $0xd2, Z21, Z1, Z6
+	VPTERNLOGQ $0xd2, Z25, Z21, Z1
+	VPTERNLOGQ $0xd2, Z26, Z25, Z21
+	VMOVDQA64 Z23, Z25
+	VMOVDQA64 Z18, Z26
+	VPTERNLOGQ $0xd2, Z13, Z18, Z23
+	VPTERNLOGQ $0xd2, Z8, Z13, Z18
+	VPTERNLOGQ $0xd2, Z3, Z8, Z13
+	VPTERNLOGQ $0xd2, Z25, Z3, Z8
+	VPTERNLOGQ $0xd2, Z26, Z25, Z3
+	VPBROADCASTQ 32(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 5: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z14, Z7, Z25
+	VPTERNLOGQ $0x96, Z23, Z16, Z25
+	VMOVDQA64 Z20, Z26
+	VPTERNLOGQ $0x96, Z9, Z2, Z26
+	VPTERNLOGQ $0x96, Z18, Z11, Z26
+	VMOVDQA64 Z15, Z27
+	VPTERNLOGQ $0x96, Z4, Z22, Z27
+	VPTERNLOGQ $0x96, Z13, Z6, Z27
+	VMOVDQA64 Z10, Z28
+	VPTERNLOGQ $0x96, Z24, Z17, Z28
+	VPTERNLOGQ $0x96, Z8, Z1, Z28
+	VMOVDQA64 Z5, Z29
+	VPTERNLOGQ $0x96, Z19, Z12, Z29
+	VPTERNLOGQ $0x96, Z3, Z21, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z23, Z23
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z18, Z18
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z13, Z13
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z8, Z8
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z3, Z3
+
+	// round 5: ρ, π
+	VPROLQ $1, Z20, Z20
+	VPROLQ $62, Z15, Z15
+	VPROLQ $28, Z10, Z10
+	VPROLQ $27, Z5, Z5
+	VPROLQ $36, Z7, Z7
+	VPROLQ $44, Z2, Z2
+	VPROLQ $6, Z22, Z22
+	VPROLQ $55, Z17, Z17
+	VPROLQ $20, Z12, Z12
+	VPROLQ $3, Z14, Z14
+	VPROLQ $10, Z9, Z9
+	VPROLQ $43, Z4, Z4
+	VPROLQ $25, Z24, Z24
+	VPROLQ $39, Z19, Z19
+	VPROLQ $41, Z16, Z16
+	VPROLQ $45, Z11, Z11
+	VPROLQ $15, Z6, Z6
+	VPROLQ $21, Z1, Z1
+	VPROLQ $8, Z21, Z21
+	VPROLQ $18, Z23, Z23
+	VPROLQ $2, Z18, Z18
+	VPROLQ $61, Z13, Z13
+	VPROLQ $56, Z8, Z8
+	VPROLQ $14, Z3, Z3
+
+	// round 5: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z2, Z26
+	VPTERNLOGQ $0xd2, Z4, Z2, Z0
+	VPTERNLOGQ $0xd2, Z1, Z4, Z2
+	VPTERNLOGQ $0xd2, Z3, Z1, Z4
+	VPTERNLOGQ $0xd2, Z25, Z3, Z1
+	VPTERNLOGQ $0xd2, Z26, Z25, Z3
+	VMOVDQA64 Z10, Z25
+	VMOVDQA64 Z12, Z26
+	VPTERNLOGQ $0xd2, Z14, Z12, Z10
+	VPTERNLOGQ $0xd2, Z11, Z14, Z12
+	VPTERNLOGQ $0xd2, Z13, Z11, Z14
+	VPTERNLOGQ $0xd2, Z25, Z13, Z11
+	VPTERNLOGQ $0xd2, Z26, Z25, Z13
+	VMOVDQA64 Z20, Z25
+	VMOVDQA64 Z22, Z26
+	VPTERNLOGQ $0xd2, Z24, Z22, Z20
+	VPTERNLOGQ $0xd2, Z21, Z24, Z22
+	VPTERNLOGQ $0xd2, Z23, Z21, Z24
+	VPTERNLOGQ $0xd2, Z25, Z23, Z21
+	VPTERNLOGQ $0xd2, Z26, Z25, Z23
+	VMOVDQA64 Z5, Z25
+	VMOVDQA64 Z7, Z26
+	VPTERNLOGQ $0xd2, Z9, Z7, Z5
+	VPTERNLOGQ $0xd2, Z6, Z9, Z7
+	VPTERNLOGQ $0xd2, Z8, Z6, Z9
+	VPTERNLOGQ $0xd2, Z25, Z8, Z6
+	VPTERNLOGQ $0xd2, Z26, Z25, Z8
+	VMOVDQA64 Z15, Z25
+	VMOVDQA64 Z17, Z26
+	VPTERNLOGQ $0xd2, Z19, Z17, Z15
+	VPTERNLOGQ $0xd2, Z16, Z19, Z17
+	VPTERNLOGQ $0xd2, Z18, Z16, Z19
+	VPTERNLOGQ $0xd2, Z25, Z18, Z16
+	VPTERNLOGQ $0xd2, Z26, Z25, Z18
+	VPBROADCASTQ 40(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 6: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z20, Z10, Z25
+	VPTERNLOGQ $0x96, Z15, Z5, Z25
+	VMOVDQA64 Z2, Z26
+	VPTERNLOGQ $0x96, Z22, Z12, Z26
+	VPTERNLOGQ $0x96, Z17, Z7, Z26
+	VMOVDQA64 Z4, Z27
+	VPTERNLOGQ $0x96, Z24, Z14, Z27
+	VPTERNLOGQ $0x96, Z19, Z9, Z27
+	VMOVDQA64 Z1, Z28
+	VPTERNLOGQ $0x96, Z21, Z11, Z28
+	VPTERNLOGQ $0x96, Z16, Z6, Z28
+	VMOVDQA64 Z3, Z29
+	VPTERNLOGQ $0x96, Z23, Z13, Z29
+	VPTERNLOGQ $0x96, Z18, Z8, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z15, Z15
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z17, Z17
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z19, Z19
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z16, Z16
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z18, Z18
+
+	// round 6: ρ, π
+	VPROLQ $1, Z2, Z2
+	VPROLQ $62, Z4, Z4
+	VPROLQ $28, Z1, Z1
+	VPROLQ $27, Z3, Z3
+	VPROLQ $36, Z10, Z10
+	VPROLQ $44, Z12, Z12
+	VPROLQ $6, Z14, Z14
+	VPROLQ $55, Z11, Z11
+	VPROLQ $20, Z13, Z13
+	VPROLQ $3, Z20, Z20
+	VPROLQ $10, Z22, Z22
+	VPROLQ $43, Z24, Z24
+	VPROLQ $25, Z21, Z21
+	VPROLQ $39, Z23, Z23
+	VPROLQ $41, Z5, Z5
+	VPROLQ $45, Z7, Z7
+	VPROLQ $15, Z9, Z9
+	VPROLQ $21, Z6, Z6
+	VPROLQ $8, Z8, Z8
+	VPROLQ $18, Z15, Z15
+	VPROLQ $2, Z17, Z17
+	VPROLQ $61, Z19, Z19
+	VPROLQ $56, Z16, Z16
+	VPROLQ $14, Z18, Z18
+
+	// round 6: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z12, Z26
+	VPTERNLOGQ $0xd2, Z24, Z12, Z0
+	VPTERNLOGQ $0xd2, Z6, Z24, Z12
+	VPTERNLOGQ $0xd2, Z18, Z6, Z24
+	VPTERNLOGQ $0xd2, Z25, Z18, Z6
+	VPTERNLOGQ $0xd2, Z26, Z25, Z18
+	VMOVDQA64 Z1, Z25
+	VMOVDQA64 Z13, Z26
+	VPTERNLOGQ $0xd2, Z20, Z13, Z1
+	VPTERNLOGQ $0xd2, Z7, Z20, Z13
+	VPTERNLOGQ $0xd2, Z19, Z7, Z20
+	VPTERNLOGQ $0xd2, Z25, Z19, Z7
+	VPTERNLOGQ $0xd2, Z26, Z25, Z19
+	VMOVDQA64 Z2, Z25
+	VMOVDQA64 Z14, Z26
+	VPTERNLOGQ $0xd2, Z21, Z14, Z2
+	VPTERNLOGQ $0xd2, Z8, Z21, Z14
+	VPTERNLOGQ $0xd2, Z15, Z8, Z21
+	VPTERNLOGQ $0xd2, Z25, Z15, Z8
+	VPTERNLOGQ $0xd2, Z26, Z25, Z15
+	VMOVDQA64 Z3, Z25
+	VMOVDQA64 Z10, Z26
+	VPTERNLOGQ $0xd2, Z22, Z10, Z3
+	VPTERNLOGQ $0xd2, Z9, Z22, Z10
+	VPTERNLOGQ $0xd2, Z16, Z9, Z22
+	VPTERNLOGQ $0xd2, Z25, Z16, Z9
+	VPTERNLOGQ $0xd2, Z26, Z25, Z16
+	VMOVDQA64 Z4, Z25
+	VMOVDQA64 Z11, Z26
+	VPTERNLOGQ $0xd2, Z23, Z11, Z4
+	VPTERNLOGQ $0xd2, Z5, Z23, Z11
+	VPTERNLOGQ $0xd2, Z17, Z5, Z23
+	VPTERNLOGQ $0xd2, Z25, Z17, Z5
+	VPTERNLOGQ $0xd2, Z26, Z25, Z17
+	VPBROADCASTQ 48(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 7: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z2, Z1, Z25
+	VPTERNLOGQ $0x96, Z4, Z3, Z25
+	VMOVDQA64 Z12, Z26
+	VPTERNLOGQ $0x96, Z14, Z13, Z26
+	VPTERNLOGQ $0x96, Z11, Z10, Z26
+	VMOVDQA64 Z24, Z27
+	VPTERNLOGQ $0x96, Z21, Z20, Z27
+	VPTERNLOGQ $0x96, Z23, Z22, Z27
+	VMOVDQA64 Z6, Z28
+	VPTERNLOGQ $0x96, Z8, Z7, Z28
+	VPTERNLOGQ $0x96, Z5, Z9, Z28
+	VMOVDQA64 Z18, Z29
+	VPTERNLOGQ $0x96, Z15, Z19, Z29
+	VPTERNLOGQ $0x96, Z17, Z16, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z4, Z4
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z11, Z11
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z23, Z23
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z5, Z5
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z17, Z17
+
+	// round 7: ρ, π
+	VPROLQ $1, Z12, Z12
+	VPROLQ $62, Z24, Z24
+	VPROLQ $28, Z6, Z6
+	VPROLQ $27, Z18, Z18
+	VPROLQ $36, Z1, Z1
+	VPROLQ $44, Z13, Z13
+	VPROLQ $6, Z20, Z20
+	VPROLQ $55, Z7, Z7
+	VPROLQ $20, Z19, Z19
+	VPROLQ $3, Z2, Z2
+	VPROLQ $10, Z14, Z14
+	VPROLQ $43, Z21, Z21
+	VPROLQ $25, Z8, Z8
+	VPROLQ $39, Z15, Z15
+	VPROLQ $41, Z3, Z3
+	VPROLQ $45, Z10, Z10
+	VPROLQ $15, Z22, Z22
+	VPROLQ $21, Z9, Z9
+	VPROLQ $8, Z16, Z16
+	VPROLQ $18, Z4, Z4
+	VPROLQ $2, Z11, Z11
+	VPROLQ $61, Z23, Z23
+	VPROLQ $56, Z5, Z5
+	VPROLQ $14, Z17, Z17
+
+	// round 7: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z13, Z26
+	VPTERNLOGQ $0xd2, Z21, Z13, Z0
+	VPTERNLOGQ $0xd2, Z9, Z21, Z13
+	VPTERNLOGQ $0xd2, Z17, Z9, Z21
+	VPTERNLOGQ $0xd2, Z25, Z17, Z9
+	VPTERNLOGQ $0xd2, Z26, Z25, Z17
+	VMOVDQA64 Z6, Z25
+	VMOVDQA64 Z19, Z26
+	VPTERNLOGQ $0xd2, Z2, Z19, Z6
+	VPTERNLOGQ $0xd2, Z10, Z2, Z19
+	VPTERNLOGQ $0xd2, Z23, Z10, Z2
+	VPTERNLOGQ $0xd2, Z25, Z23, Z10
+	VPTERNLOGQ $0xd2, Z26, Z25, Z23
+	VMOVDQA64 Z12, Z25
+	VMOVDQA64 Z20, Z26
+	VPTERNLOGQ $0xd2, Z8, Z20, Z12
+	VPTERNLOGQ $0xd2, Z16, Z8, Z20
+	VPTERNLOGQ $0xd2, Z4, Z16, Z8
+	VPTERNLOGQ $0xd2, Z25, Z4, Z16
+	VPTERNLOGQ $0xd2, Z26, Z25, Z4
+	VMOVDQA64 Z18, Z25
+	VMOVDQA64 Z1, Z26
+	VPTERNLOGQ $0xd2, Z14, Z1, Z18
+	VPTERNLOGQ $0xd2, Z22, Z14, Z1
+	VPTERNLOGQ $0xd2, Z5, Z22, Z14
+	VPTERNLOGQ $0xd2, Z25, Z5, Z22
+	VPTERNLOGQ $0xd2, Z26, Z25, Z5
+	VMOVDQA64 Z24, Z25
+	VMOVDQA64 Z7, Z26
+	VPTERNLOGQ $0xd2, Z15, Z7, Z24
+	VPTERNLOGQ $0xd2, Z3, Z15, Z7
+	VPTERNLOGQ $0xd2, Z11, Z3, Z15
+	VPTERNLOGQ $0xd2, Z25, Z11, Z3
+	VPTERNLOGQ $0xd2, Z26, Z25, Z11
+	VPBROADCASTQ 56(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 8: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z12, Z6, Z25
+	VPTERNLOGQ $0x96, Z24, Z18, Z25
+	VMOVDQA64 Z13, Z26
+	VPTERNLOGQ $0x96, Z20, Z19, Z26
+	VPTERNLOGQ $0x96, Z7, Z1, Z26
+	VMOVDQA64 Z21, Z27
+	VPTERNLOGQ $0x96, Z8, Z2, Z27
+	VPTERNLOGQ $0x96, Z15, Z14, Z27
+	VMOVDQA64 Z9, Z28
+	VPTERNLOGQ $0x96, Z16, Z10, Z28
+	VPTERNLOGQ $0x96, Z3, Z22, Z28
+	VMOVDQA64 Z17, Z29
+	VPTERNLOGQ $0x96, Z4, Z23, Z29
+	VPTERNLOGQ $0x96, Z11, Z5, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z24, Z24
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z7, Z7
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z15, Z15
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z3, Z3
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z11, Z11
+
+	// round 8: ρ, π
+	VPROLQ $1, Z13, Z13
+	VPROLQ $62, Z21, Z21
+	VPROLQ $28, Z9, Z9
+	VPROLQ $27, Z17, Z17
+	VPROLQ $36, Z6, Z6
+	VPROLQ $44, Z19, Z19
+	VPROLQ $6, Z2, Z2
+	VPROLQ $55, Z10, Z10
+	VPROLQ $20, Z23, Z23
+	VPROLQ $3, Z12, Z12
+	VPROLQ $10, Z20, Z20
+	VPROLQ $43, Z8, Z8
+	VPROLQ $25, Z16, Z16
+	VPROLQ $39, Z4, Z4
+	VPROLQ $41, Z18, Z18
+	VPROLQ $45, Z1, Z1
+	VPROLQ $15, Z14, Z14
+	VPROLQ $21, Z22, Z22
+	VPROLQ $8, Z5, Z5
+	VPROLQ $18, Z24, Z24
+	VPROLQ $2, Z7, Z7
+	VPROLQ $61, Z15, Z15
+	VPROLQ $56, Z3, Z3
+	VPROLQ $14, Z11, Z11
+
+	// round 8: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z19, Z26
+	VPTERNLOGQ $0xd2, Z8, Z19, Z0
+	VPTERNLOGQ $0xd2, Z22, Z8, Z19
+	VPTERNLOGQ $0xd2, Z11, Z22, Z8
+	VPTERNLOGQ $0xd2, Z25, Z11, Z22
+	VPTERNLOGQ $0xd2, Z26, Z25, Z11
+	VMOVDQA64 Z9, Z25
+	VMOVDQA64 Z23, Z26
+	VPTERNLOGQ $0xd2, Z12, Z23, Z9
+	VPTERNLOGQ $0xd2, Z1, Z12, Z23
+	VPTERNLOGQ $0xd2, Z15, Z1, Z12
+	VPTERNLOGQ $0xd2, Z25, Z15, Z1
+	VPTERNLOGQ $0xd2, Z26, Z25, Z15
+	VMOVDQA64 Z13, Z25
+	VMOVDQA64 Z2, Z26
+	VPTERNLOGQ $0xd2, Z16, Z2, Z13
+	VPTERNLOGQ $0xd2, Z5, Z16, Z2
+	VPTERNLOGQ $0xd2, Z24, Z5, Z16
+	VPTERNLOGQ $0xd2, Z25, Z24, Z5
+	VPTERNLOGQ $0xd2, Z26, Z25, Z24
+	VMOVDQA64 Z17, Z25
+	VMOVDQA64 Z6, Z26
+	VPTERNLOGQ $0xd2, Z20, Z6, Z17
+	VPTERNLOGQ $0xd2, Z14, Z20, Z6
+	VPTERNLOGQ $0xd2, Z3, Z14, Z20
+	VPTERNLOGQ $0xd2, Z25, Z3, Z14
+	VPTERNLOGQ $0xd2, Z26, Z25, Z3
+	VMOVDQA64 Z21, Z25
+	VMOVDQA64 Z10, Z26
+	VPTERNLOGQ $0xd2, Z4, Z10, Z21
+	VPTERNLOGQ $0xd2, Z18, Z4, Z10
+	VPTERNLOGQ $0xd2, Z7, Z18, Z4
+	VPTERNLOGQ $0xd2, Z25, Z7, Z18
+	VPTERNLOGQ $0xd2, Z26, Z25, Z7
+	VPBROADCASTQ 64(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 9: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z13, Z9, Z25
+	VPTERNLOGQ $0x96, Z21, Z17, Z25
+	VMOVDQA64 Z19, Z26
+	VPTERNLOGQ $0x96, Z2, Z23, Z26
+	VPTERNLOGQ $0x96, Z10, Z6, Z26
+	VMOVDQA64 Z8, Z27
+	VPTERNLOGQ $0x96, Z16, Z12, Z27
+	VPTERNLOGQ $0x96, Z4, Z20, Z27
+	VMOVDQA64 Z22, Z28
+	VPTERNLOGQ $0x96, Z5, Z1, Z28
+	VPTERNLOGQ $0x96, Z18, Z14, Z28
+	VMOVDQA64 Z11, Z29
+	VPTERNLOGQ $0x96, Z24, Z15, Z29
+	VPTERNLOGQ $0x96, Z7, Z3, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z21, Z21
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z10, Z10
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z4, Z4
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z18, Z18
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z7, Z7
+
+	// round 9: ρ, π
+	VPROLQ $1, Z19, Z19
+	VPROLQ $62, Z8, Z8
+	VPROLQ $28, Z22, Z22
+	VPROLQ $27, Z11, Z11
+	VPROLQ $36, Z9, Z9
+	VPROLQ $44, Z23, Z23
+	VPROLQ $6, Z12, Z12
+	VPROLQ $55, Z1, Z1
+	VPROLQ $20, Z15, Z15
+	VPROLQ $3, Z13, Z13
+	VPROLQ $10, Z2, Z2
+	VPROLQ $43, Z16, Z16
+	VPROLQ $25, Z5, Z5
+	VPROLQ $39, Z24, Z24
+	VPROLQ $41, Z17, Z17
+	VPROLQ $45, Z6, Z6
+	VPROLQ $15, Z20, Z20
+	VPROLQ $21, Z14, Z14
+	VPROLQ $8, Z3, Z3
+	VPROLQ $18, Z21, Z21
+	VPROLQ $2, Z10, Z10
+	VPROLQ $61, Z4, Z4
+	VPROLQ $56, Z18, Z18
+	VPROLQ $14, Z7, Z7
+
+	// round 9: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z23, Z26
+	VPTERNLOGQ $0xd2, Z16, Z23, Z0
+	VPTERNLOGQ $0xd2, Z14, Z16, Z23
+	VPTERNLOGQ $0xd2, Z7, Z14, Z16
+	VPTERNLOGQ $0xd2, Z25, Z7, Z14
+	VPTERNLOGQ $0xd2, Z26, Z25, Z7
+	VMOVDQA64 Z22, Z25
+	VMOVDQA64 Z15, Z26
+	VPTERNLOGQ $0xd2, Z13, Z15, Z22
+	VPTERNLOGQ $0xd2, Z6, Z13, Z15
+	VPTERNLOGQ $0xd2, Z4, Z6, Z13
+	VPTERNLOGQ $0xd2, Z25, Z4, Z6
+	VPTERNLOGQ $0xd2, Z26, Z25, Z4
+	VMOVDQA64 Z19, Z25
+	VMOVDQA64 Z12, Z26
+	VPTERNLOGQ $0xd2, Z5, Z12, Z19
+	VPTERNLOGQ $0xd2, Z3, Z5, Z12
+	VPTERNLOGQ $0xd2, Z21, Z3, Z5
+	VPTERNLOGQ $0xd2, Z25, Z21, Z3
+	VPTERNLOGQ $0xd2, Z26, Z25, Z21
+	VMOVDQA64 Z11, Z25
+	VMOVDQA64 Z9, Z26
+	VPTERNLOGQ $0xd2, Z2, Z9, Z11
+	VPTERNLOGQ $0xd2, Z20, Z2, Z9
+	VPTERNLOGQ $0xd2, Z18, Z20, Z2
+	VPTERNLOGQ $0xd2, Z25, Z18, Z20
+	VPTERNLOGQ $0xd2, Z26, Z25, Z18
+	VMOVDQA64 Z8, Z25
+	VMOVDQA64 Z1, Z26
+	VPTERNLOGQ $0xd2, Z24, Z1, Z8
+	VPTERNLOGQ $0xd2, Z17, Z24, Z1
+	VPTERNLOGQ $0xd2, Z10, Z17, Z24
+	VPTERNLOGQ $0xd2, Z25, Z10, Z17
+	VPTERNLOGQ $0xd2, Z26, Z25, Z10
+	VPBROADCASTQ 72(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 10: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z19, Z22, Z25
+	VPTERNLOGQ $0x96, Z8, Z11, Z25
+	VMOVDQA64 Z23, Z26
+	VPTERNLOGQ $0x96, Z12, Z15, Z26
+	VPTERNLOGQ $0x96, Z1, Z9, Z26
+	VMOVDQA64 Z16, Z27
+	VPTERNLOGQ $0x96, Z5, Z13, Z27
+	VPTERNLOGQ $0x96, Z24, Z2, Z27
+	VMOVDQA64 Z14, Z28
+	VPTERNLOGQ $0x96, Z3, Z6, Z28
+	VPTERNLOGQ $0x96, Z17, Z20, Z28
+	VMOVDQA64 Z7, Z29
+	VPTERNLOGQ $0x96, Z21, Z4, Z29
+	VPTERNLOGQ $0x96, Z10, Z18, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z8, Z8
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z1, Z1
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z24, Z24
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z17, Z17
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z10, Z10
+
+	// round 10: ρ, π
+	VPROLQ $1, Z23, Z23
+	VPROLQ $62, Z16, Z16
+	VPROLQ $28, Z14, Z14
+	VPROLQ $27, Z7, Z7
+	VPROLQ $36, Z22, Z22
+	VPROLQ $44, Z15, Z15
+	VPROLQ $6, Z13, Z13
+	VPROLQ $55, Z6, Z6
+	VPROLQ $20, Z4, Z4
+	VPROLQ $3, Z19, Z19
+	VPROLQ $10, Z12, Z12
+	VPROLQ $43, Z5, Z5
+	VPROLQ $25, Z3, Z3
+	VPROLQ $39, Z21, Z21
+	VPROLQ $41, Z11, Z11
+	VPROLQ $45, Z9, Z9
+	VPROLQ $15, Z2, Z2
+	VPROLQ $21, Z20, Z20
+	VPROLQ $8, Z18, Z18
+	VPROLQ $18, Z8, Z8
+	VPROLQ $2, Z1, Z1
+	VPROLQ $61, Z24, Z24
+	VPROLQ $56, Z17, Z17
+	VPROLQ $14, Z10, Z10
+
+	// round 10: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z15, Z26
+	VPTERNLOGQ $0xd2, Z5, Z15, Z0
+	VPTERNLOGQ $0xd2, Z20, Z5, Z15
+	VPTERNLOGQ $0xd2, Z10, Z20, Z5
+	VPTERNLOGQ $0xd2, Z25, Z10, Z20
+	VPTERNLOGQ $0xd2, Z26, Z25, Z10
+	VMOVDQA64 Z14, Z25
+	VMOVDQA64 Z4, Z26
+	VPTERNLOGQ $0xd2, Z19, Z4, Z14
+	VPTERNLOGQ $0xd2, Z9, Z19, Z4
+	VPTERNLOGQ $0xd2, Z24, Z9, Z19
+	VPTERNLOGQ $0xd2, Z25, Z24, Z9
+	VPTERNLOGQ $0xd2, Z26, Z25, Z24
+	VMOVDQA64 Z23, Z25
+	VMOVDQA64 Z13, Z26
+	VPTERNLOGQ $0xd2, Z3, Z13, Z23
+	VPTERNLOGQ $0xd2, Z18, Z3, Z13
+	VPTERNLOGQ $0xd2, Z8, Z18, Z3
+	VPTERNLOGQ $0xd2, Z25, Z8, Z18
+	VPTERNLOGQ $0xd2, Z26, Z25, Z8
+	VMOVDQA64 Z7, Z25
+	VMOVDQA64 Z22, Z26
+	VPTERNLOGQ $0xd2, Z12, Z22, Z7
+	VPTERNLOGQ $0xd2, Z2, Z12, Z22
+	VPTERNLOGQ $0xd2, Z17, Z2, Z12
+	VPTERNLOGQ $0xd2, Z25, Z17, Z2
+	VPTERNLOGQ $0xd2, Z26, Z25, Z17
+	VMOVDQA64 Z16, Z25
+	VMOVDQA64 Z6, Z26
+	VPTERNLOGQ $0xd2, Z21, Z6, Z16
+	VPTERNLOGQ $0xd2, Z11, Z21, Z6
+	VPTERNLOGQ $0xd2, Z1, Z11, Z21
+	VPTERNLOGQ $0xd2, Z25, Z1, Z11
+	VPTERNLOGQ $0xd2, Z26, Z25, Z1
+	VPBROADCASTQ 80(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 11: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z23, Z14, Z25
+	VPTERNLOGQ $0x96, Z16, Z7, Z25
+	VMOVDQA64 Z15, Z26
+	VPTERNLOGQ $0x96, Z13, Z4, Z26
+	VPTERNLOGQ $0x96, Z6, Z22, Z26
+	VMOVDQA64 Z5, Z27
+	VPTERNLOGQ $0x96, Z3, Z19, Z27
+	VPTERNLOGQ $0x96, Z21, Z12, Z27
+	VMOVDQA64 Z20, Z28
+	VPTERNLOGQ $0x96, Z18, Z9, Z28
+	VPTERNLOGQ $0x96, Z11, Z2, Z28
+	VMOVDQA64 Z10, Z29
+	VPTERNLOGQ $0x96, Z8, Z24, Z29
+	VPTERNLOGQ $0x96, Z1, Z17, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z16, Z16
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z6, Z6
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z21, Z21
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z11, Z11
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z1, Z1
+
+	// round 11: ρ, π
+	VPROLQ $1, Z15, Z15
+	VPROLQ $62, Z5, Z5
+	VPROLQ $28, Z20, Z20
+	VPROLQ $27, Z10, Z10
+	VPROLQ $36, Z14, Z14
+	VPROLQ $44, Z4, Z4
+	VPROLQ $6, Z19, Z19
+	VPROLQ $55, Z9, Z9
+	VPROLQ $20, Z24, Z24
+	VPROLQ $3, Z23, Z23
+	VPROLQ $10, Z13, Z13
+	VPROLQ $43, Z3, Z3
+	VPROLQ $25, Z18, Z18
+	VPROLQ $39, Z8, Z8
+	VPROLQ $41, Z7, Z7
+	VPROLQ $45, Z22, Z22
+	VPROLQ $15, Z12, Z12
+	VPROLQ $21, Z2, Z2
+	VPROLQ $8, Z17, Z17
+	VPROLQ $18, Z16, Z16
+	VPROLQ $2, Z6, Z6
+	VPROLQ $61, Z21, Z21
+	VPROLQ $56, Z11, Z11
+	VPROLQ $14, Z1, Z1
+
+	// round 11: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z4, Z26
+	VPTERNLOGQ $0xd2, Z3, Z4, Z0
+	VPTERNLOGQ $0xd2, Z2, Z3, Z4
+	VPTERNLOGQ $0xd2, Z1, Z2, Z3
+	VPTERNLOGQ $0xd2, Z25, Z1, Z2
+	VPTERNLOGQ $0xd2, Z26, Z25, Z1
+	VMOVDQA64 Z20, Z25
+	VMOVDQA64 Z24, Z26
+	VPTERNLOGQ $0xd2, Z23, Z24, Z20
+	VPTERNLOGQ $0xd2, Z22, Z23, Z24
+	VPTERNLOGQ $0xd2, Z21, Z22, Z23
+	VPTERNLOGQ $0xd2, Z25, Z21, Z22
+	VPTERNLOGQ $0xd2, Z26, Z25, Z21
+	VMOVDQA64 Z15, Z25
+	VMOVDQA64 Z19, Z26
+	VPTERNLOGQ $0xd2, Z18, Z19, Z15
+	VPTERNLOGQ $0xd2, Z17, Z18, Z19
+	VPTERNLOGQ $0xd2, Z16, Z17, Z18
+	VPTERNLOGQ $0xd2, Z25, Z16, Z17
+	VPTERNLOGQ $0xd2, Z26, Z25, Z16
+	VMOVDQA64 Z10, Z25
+	VMOVDQA64 Z14, Z26
+	VPTERNLOGQ $0xd2, Z13, Z14, Z10
+	VPTERNLOGQ $0xd2, Z12, Z13, Z14
+	VPTERNLOGQ $0xd2, Z11, Z12, Z13
+	VPTERNLOGQ $0xd2, Z25, Z11, Z12
+	VPTERNLOGQ $0xd2, Z26, Z25, Z11
+	VMOVDQA64 Z5, Z25
+	VMOVDQA64 Z9, Z26
+	VPTERNLOGQ $0xd2, Z8, Z9, Z5
+	VPTERNLOGQ $0xd2, Z7, Z8, Z9
+	VPTERNLOGQ $0xd2, Z6, Z7, Z8
+	VPTERNLOGQ $0xd2, Z25, Z6, Z7
+	VPTERNLOGQ $0xd2, Z26, Z25, Z6
+	VPBROADCASTQ 88(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 12: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z15, Z20, Z25
+	VPTERNLOGQ $0x96, Z5, Z10, Z25
+	VMOVDQA64 Z4, Z26
+	VPTERNLOGQ $0x96, Z19, Z24, Z26
+	VPTERNLOGQ $0x96, Z9, Z14, Z26
+	VMOVDQA64 Z3, Z27
+	VPTERNLOGQ $0x96, Z18, Z23, Z27
+	VPTERNLOGQ $0x96, Z8, Z13, Z27
+	VMOVDQA64 Z2, Z28
+	VPTERNLOGQ $0x96, Z17, Z22, Z28
+	VPTERNLOGQ $0x96, Z7, Z12, Z28
+	VMOVDQA64 Z1, Z29
+	VPTERNLOGQ $0x96, Z16, Z21, Z29
+	VPTERNLOGQ $0x96, Z6, Z11, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z5, Z5
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z9, Z9
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z8, Z8
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z7, Z7
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z6, Z6
+
+	// round 12: ρ, π
+	VPROLQ $1, Z4, Z4
+	VPROLQ $62, Z3, Z3
+	VPROLQ $28, Z2, Z2
+	VPROLQ $27, Z1, Z1
+	VPROLQ $36, Z20, Z20
+	VPROLQ $44, Z24, Z24
+	VPROLQ $6, Z23, Z23
+	VPROLQ $55, Z22, Z22
+	VPROLQ $20, Z21, Z21
+	VPROLQ $3, Z15, Z15
+	VPROLQ $10, Z19, Z19
+	VPROLQ $43, Z18, Z18
+	VPROLQ $25, Z17, Z17
+	VPROLQ $39, Z16, Z16
+	VPROLQ $41, Z10, Z10
+	VPROLQ $45, Z14, Z14
+	VPROLQ $15, Z13, Z13
+	VPROLQ $21, Z12, Z12
+	VPROLQ $8, Z11, Z11
+	VPROLQ $18, Z5, Z5
+	VPROLQ $2, Z9, Z9
+	VPROLQ $61, Z8, Z8
+	VPROLQ $56, Z7, Z7
+	VPROLQ $14, Z6, Z6
+
+	// round 12: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z24, Z26
+	VPTERNLOGQ $0xd2, Z18, Z24, Z0
+	VPTERNLOGQ $0xd2, Z12, Z18, Z24
+	VPTERNLOGQ $0xd2, Z6, Z12, Z18
+	VPTERNLOGQ $0xd2, Z25, Z6, Z12
+	VPTERNLOGQ $0xd2, Z26, Z25, Z6
+	VMOVDQA64 Z2, Z25
+	VMOVDQA64 Z21, Z26
+	VPTERNLOGQ $0xd2, Z15, Z21, Z2
+	VPTERNLOGQ $0xd2, Z14, Z15, Z21
+	VPTERNLOGQ $0xd2, Z8, Z14, Z15
+	VPTERNLOGQ $0xd2, Z25, Z8, Z14
+	VPTERNLOGQ $0xd2, Z26, Z25, Z8
+	VMOVDQA64 Z4, Z25
+	VMOVDQA64 Z23, Z26
+	VPTERNLOGQ $0xd2, Z17, Z23, Z4
+	VPTERNLOGQ $0xd2, Z11, Z17, Z23
+	VPTERNLOGQ $0xd2, Z5, Z11, Z17
+	VPTERNLOGQ $0xd2, Z25, Z5, Z11
+	VPTERNLOGQ $0xd2, Z26, Z25, Z5
+	VMOVDQA64 Z1, Z25
+	VMOVDQA64 Z20, Z26
+	VPTERNLOGQ $0xd2, Z19, Z20, Z1
+	VPTERNLOGQ $0xd2, Z13, Z19, Z20
+	VPTERNLOGQ $0xd2, Z7, Z13, Z19
+	VPTERNLOGQ $0xd2, Z25, Z7, Z13
+	VPTERNLOGQ $0xd2, Z26, Z25, Z7
+	VMOVDQA64 Z3, Z25
+	VMOVDQA64 Z22, Z26
+	VPTERNLOGQ $0xd2, Z16, Z22, Z3
+	VPTERNLOGQ $0xd2, Z10, Z16, Z22
+	VPTERNLOGQ $0xd2, Z9, Z10, Z16
+	VPTERNLOGQ $0xd2, Z25, Z9, Z10
+	VPTERNLOGQ $0xd2, Z26, Z25, Z9
+	VPBROADCASTQ 96(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 13: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z4, Z2, Z25
+	VPTERNLOGQ $0x96, Z3, Z1, Z25
+	VMOVDQA64 Z24, Z26
+	VPTERNLOGQ $0x96, Z23, Z21, Z26
+	VPTERNLOGQ $0x96, Z22, Z20, Z26
+	VMOVDQA64 Z18, Z27
+	VPTERNLOGQ $0x96, Z17, Z15, Z27
+	VPTERNLOGQ $0x96, Z16, Z19, Z27
+	VMOVDQA64 Z12, Z28
+	VPTERNLOGQ $0x96, Z11, Z14, Z28
+	VPTERNLOGQ $0x96, Z10, Z13, Z28
+	VMOVDQA64 Z6, Z29
+	VPTERNLOGQ $0x96, Z5, Z8, Z29
+	VPTERNLOGQ $0x96, Z9, Z7, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z3, Z3
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z22, Z22
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z16, Z16
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z10, Z10
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z9, Z9
+
+	// round 13: ρ, π
+	VPROLQ $1, Z24, Z24
+	VPROLQ $62, Z18, Z18
+	VPROLQ $28, Z12, Z12
+	VPROLQ $27, Z6, Z6
+	VPROLQ $36, Z2, Z2
+	VPROLQ $44, Z21, Z21
+	VPROLQ $6, Z15, Z15
+	VPROLQ $55, Z14, Z14
+	VPROLQ $20, Z8, Z8
+	VPROLQ $3, Z4, Z4
+	VPROLQ $10, Z23, Z23
+	VPROLQ $43, Z17, Z17
+	VPROLQ $25, Z11, Z11
+	VPROLQ $39, Z5, Z5
+	VPROLQ $41, Z1, Z1
+	VPROLQ $45, Z20, Z20
+	VPROLQ $15, Z19, Z19
+	VPROLQ $21, Z13, Z13
+	VPROLQ $8, Z7, Z7
+	VPROLQ $18, Z3, Z3
+	VPROLQ $2, Z22, Z22
+	VPROLQ $61, Z16, Z16
+	VPROLQ $56, Z10, Z10
+	VPROLQ $14, Z9, Z9
+
+	// round 13: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z21, Z26
+	VPTERNLOGQ $0xd2, Z17, Z21, Z0
+	VPTERNLOGQ $0xd2, Z13, Z17, Z21
+	VPTERNLOGQ $0xd2, Z9, Z13, Z17
+	VPTERNLOGQ $0xd2, Z25, Z9, Z13
+	VPTERNLOGQ $0xd2, Z26, Z25, Z9
+	VMOVDQA64 Z12, Z25
+	VMOVDQA64 Z8, Z26
+	VPTERNLOGQ $0xd2, Z4, Z8, Z12
+	VPTERNLOGQ $0xd2, Z20, Z4, Z8
+	VPTERNLOGQ $0xd2, Z16, Z20, Z4
+	VPTERNLOGQ $0xd2, Z25, Z16, Z20
+	VPTERNLOGQ $0xd2, Z26, Z25, Z16
+	VMOVDQA64 Z24, Z25
+	VMOVDQA64 Z15, Z26
+	VPTERNLOGQ $0xd2, Z11, Z15, Z24
+	VPTERNLOGQ $0xd2, Z7, Z11, Z15
+	VPTERNLOGQ $0xd2, Z3, Z7, Z11
+	VPTERNLOGQ $0xd2, Z25, Z3, Z7
+	VPTERNLOGQ $0xd2, Z26, Z25, Z3
+	VMOVDQA64 Z6, Z25
+	VMOVDQA64 Z2, Z26
+	VPTERNLOGQ $0xd2, Z23, Z2, Z6
+	VPTERNLOGQ $0xd2, Z19, Z23, Z2
+	VPTERNLOGQ $0xd2, Z10, Z19, Z23
+	VPTERNLOGQ $0xd2, Z25, Z10, Z19
+	VPTERNLOGQ $0xd2, Z26, Z25, Z10
+	VMOVDQA64 Z18, Z25
+	VMOVDQA64 Z14, Z26
+	VPTERNLOGQ $0xd2, Z5, Z14, Z18
+	VPTERNLOGQ $0xd2, Z1, Z5, Z14
+	VPTERNLOGQ $0xd2, Z22, Z1, Z5
+	VPTERNLOGQ $0xd2, Z25, Z22, Z1
+	VPTERNLOGQ $0xd2, Z26, Z25, Z22
+	VPBROADCASTQ 104(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 14: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z24, Z12, Z25
+	VPTERNLOGQ $0x96, Z18, Z6, Z25
+	VMOVDQA64 Z21, Z26
+	VPTERNLOGQ $0x96, Z15, Z8, Z26
+	VPTERNLOGQ $0x96, Z14, Z2, Z26
+	VMOVDQA64 Z17, Z27
+	VPTERNLOGQ $0x96, Z11, Z4, Z27
+	VPTERNLOGQ $0x96, Z5, Z23, Z27
+	VMOVDQA64 Z13, Z28
+	VPTERNLOGQ $0x96, Z7, Z20, Z28
+	VPTERNLOGQ $0x96, Z1, Z19, Z28
+	VMOVDQA64 Z9, Z29
+	VPTERNLOGQ $0x96, Z3, Z16, Z29
+	VPTERNLOGQ $0x96, Z22, Z10, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z18, Z18
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z14, Z14
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z5, Z5
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z1, Z1
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z22, Z22
+
+	// round 14: ρ, π
+	VPROLQ $1, Z21, Z21
+	VPROLQ $62, Z17, Z17
+	VPROLQ $28, Z13, Z13
+	VPROLQ $27, Z9, Z9
+	VPROLQ $36, Z12, Z12
+	VPROLQ $44, Z8, Z8
+	VPROLQ $6, Z4, Z4
+	VPROLQ $55, Z20, Z20
+	VPROLQ $20, Z16, Z16
+	VPROLQ $3, Z24, Z24
+	VPROLQ $10, Z15, Z15
+	VPROLQ $43, Z11, Z11
+	VPROLQ $25, Z7, Z7
+	VPROLQ $39, Z3, Z3
+	VPROLQ $41, Z6, Z6
+	VPROLQ $45, Z2, Z2
+	VPROLQ $15, Z23, Z23
+	VPROLQ $21, Z19, Z19
+	VPROLQ $8, Z10, Z10
+	VPROLQ $18, Z18, Z18
+	VPROLQ $2, Z14, Z14
+	VPROLQ $61, Z5, Z5
+	VPROLQ $56, Z1, Z1
+	VPROLQ $14, Z22, Z22
+
+	// round 14: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z8, Z26
+	VPTERNLOGQ $0xd2, Z11, Z8, Z0
+	VPTERNLOGQ $0xd2, Z19, Z11, Z8
+	VPTERNLOGQ $0xd2, Z22, Z19, Z11
+	VPTERNLOGQ $0xd2, Z25, Z22, Z19
+	VPTERNLOGQ $0xd2, Z26, Z25, Z22
+	VMOVDQA64 Z13, Z25
+	VMOVDQA64 Z16, Z26
+	VPTERNLOGQ $0xd2, Z24, Z16, Z13
+	VPTERNLOGQ $0xd2, Z2, Z24, Z16
+	VPTERNLOGQ $0xd2, Z5, Z2, Z24
+	VPTERNLOGQ $0xd2, Z25, Z5, Z2
+	VPTERNLOGQ $0xd2, Z26, Z25, Z5
+	VMOVDQA64 Z21, Z25
+	VMOVDQA64 Z4, Z26
+	VPTERNLOGQ $0xd2, Z7, Z4, Z21
+	VPTERNLOGQ $0xd2, Z10, Z7, Z4
+	VPTERNLOGQ $0xd2, Z18, Z10, Z7
+	VPTERNLOGQ $0xd2, Z25, Z18, Z10
+	VPTERNLOGQ $0xd2, Z26, Z25, Z18
+	VMOVDQA64 Z9, Z25
+	VMOVDQA64 Z12, Z26
+	VPTERNLOGQ $0xd2, Z15, Z12, Z9
+	VPTERNLOGQ $0xd2, Z23, Z15, Z12
+	VPTERNLOGQ $0xd2, Z1, Z23, Z15
+	VPTERNLOGQ $0xd2, Z25, Z1, Z23
+	VPTERNLOGQ $0xd2, Z26, Z25, Z1
+	VMOVDQA64 Z17, Z25
+	VMOVDQA64 Z20, Z26
+	VPTERNLOGQ $0xd2, Z3, Z20, Z17
+	VPTERNLOGQ $0xd2, Z6, Z3, Z20
+	VPTERNLOGQ $0xd2, Z14, Z6, Z3
+	VPTERNLOGQ $0xd2, Z25, Z14, Z6
+	VPTERNLOGQ $0xd2, Z26, Z25, Z14
+	VPBROADCASTQ 112(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 15: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z21, Z13, Z25
+	VPTERNLOGQ $0x96, Z17, Z9, Z25
+	VMOVDQA64 Z8, Z26
+	VPTERNLOGQ $0x96, Z4, Z16, Z26
+	VPTERNLOGQ $0x96, Z20, Z12, Z26
+	VMOVDQA64 Z11, Z27
+	VPTERNLOGQ $0x96, Z7, Z24, Z27
+	VPTERNLOGQ $0x96, Z3, Z15, Z27
+	VMOVDQA64 Z19, Z28
+	VPTERNLOGQ $0x96, Z10, Z2, Z28
+	VPTERNLOGQ $0x96, Z6, Z23, Z28
+	VMOVDQA64 Z22, Z29
+	VPTERNLOGQ $0x96, Z18, Z5, Z29
+	VPTERNLOGQ $0x96, Z14, Z1, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z17, Z17
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z20, Z20
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z3, Z3
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z6, Z6
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z14, Z14
+
+	// round 15: ρ, π
+	VPROLQ $1, Z8, Z8
+	VPROLQ $62, Z11, Z11
+	VPROLQ $28, Z19, Z19
+	VPROLQ $27, Z22, Z22
+	VPROLQ $36, Z13, Z13
+	VPROLQ $44, Z16, Z16
+	VPROLQ $6, Z24, Z24
+	VPROLQ $55, Z2, Z2
+	VPROLQ $20, Z5, Z5
+	VPROLQ $3, Z21, Z21
+	VPROLQ $10, Z4, Z4
+	VPROLQ $43, Z7, Z7
+	VPROLQ $25, Z10, Z10
+	VPROLQ $39, Z18, Z18
+	VPROLQ $41, Z9, Z9
+	VPROLQ $45, Z12, Z12
+	VPROLQ $15, Z15, Z15
+	VPROLQ $21, Z23, Z23
+	VPROLQ $8, Z1, Z1
+	VPROLQ $18, Z17, Z17
+	VPROLQ $2, Z20, Z20
+	VPROLQ $61, Z3, Z3
+	VPROLQ $56, Z6, Z6
+	VPROLQ $14, Z14, Z14
+
+	// round 15: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z16, Z26
+	VPTERNLOGQ $0xd2, Z7, Z16, Z0
+	VPTERNLOGQ $0xd2, Z23, Z7, Z16
+	VPTERNLOGQ $0xd2, Z14, Z23, Z7
+	VPTERNLOGQ $0xd2, Z25, Z14, Z23
+	VPTERNLOGQ $0xd2, Z26, Z25, Z14
+	VMOVDQA64 Z19, Z25
+	VMOVDQA64 Z5, Z26
+	VPTERNLOGQ $0xd2, Z21, Z5, Z19
+	VPTERNLOGQ $0xd2, Z12, Z21, Z5
+	VPTERNLOGQ $0xd2, Z3, Z12, Z21
+	VPTERNLOGQ $0xd2, Z25, Z3, Z12
+	VPTERNLOGQ $0xd2, Z26, Z25, Z3
+	VMOVDQA64 Z8, Z25
+	VMOVDQA64 Z24, Z26
+	VPTERNLOGQ $0xd2, Z10, Z24, Z8
+	VPTERNLOGQ $0xd2, Z1, Z10, Z24
+	VPTERNLOGQ $0xd2, Z17, Z1, Z10
+	VPTERNLOGQ $0xd2, Z25, Z17, Z1
+	VPTERNLOGQ $0xd2, Z26, Z25, Z17
+	VMOVDQA64 Z22, Z25
+	VMOVDQA64 Z13, Z26
+	VPTERNLOGQ $0xd2, Z4, Z13, Z22
+	VPTERNLOGQ $0xd2, Z15, Z4, Z13
+	VPTERNLOGQ $0xd2, Z6, Z15, Z4
+	VPTERNLOGQ $0xd2, Z25, Z6, Z15
+	VPTERNLOGQ $0xd2, Z26, Z25, Z6
+	VMOVDQA64 Z11, Z25
+	VMOVDQA64 Z2, Z26
+	VPTERNLOGQ $0xd2, Z18, Z2, Z11
+	VPTERNLOGQ $0xd2, Z9, Z18, Z2
+	VPTERNLOGQ $0xd2, Z20, Z9, Z18
+	VPTERNLOGQ $0xd2, Z25, Z20, Z9
+	VPTERNLOGQ $0xd2, Z26, Z25, Z20
+	VPBROADCASTQ 120(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 16: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z8, Z19, Z25
+	VPTERNLOGQ $0x96, Z11, Z22, Z25
+	VMOVDQA64 Z16, Z26
+	VPTERNLOGQ $0x96, Z24, Z5, Z26
+	VPTERNLOGQ $0x96, Z2, Z13, Z26
+	VMOVDQA64 Z7, Z27
+	VPTERNLOGQ $0x96, Z10, Z21, Z27
+	VPTERNLOGQ $0x96, Z18, Z4, Z27
+	VMOVDQA64 Z23, Z28
+	VPTERNLOGQ $0x96, Z1, Z12, Z28
+	VPTERNLOGQ $0x96, Z9, Z15, Z28
+	VMOVDQA64 Z14, Z29
+	VPTERNLOGQ $0x96, Z17, Z3, Z29
+	VPTERNLOGQ $0x96, Z20, Z6, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z11, Z11
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z2, Z2
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z18, Z18
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z9, Z9
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z20, Z20
+
+	// round 16: ρ, π
+	VPROLQ $1, Z16, Z16
+	VPROLQ $62, Z7, Z7
+	VPROLQ $28, Z23, Z23
+	VPROLQ $27, Z14, Z14
+	VPROLQ $36, Z19, Z19
+	VPROLQ $44, Z5, Z5
+	VPROLQ $6, Z21, Z21
+	VPROLQ $55, Z12, Z12
+	VPROLQ $20, Z3, Z3
+	VPROLQ $3, Z8, Z8
+	VPROLQ $10, Z24, Z24
+	VPROLQ $43, Z10, Z10
+	VPROLQ $25, Z1, Z1
+	VPROLQ $39, Z17, Z17
+	VPROLQ $41, Z22, Z22
+	VPROLQ $45, Z13, Z13
+	VPROLQ $15, Z4, Z4
+	VPROLQ $21, Z15, Z15
+	VPROLQ $8, Z6, Z6
+	VPROLQ $18, Z11, Z11
+	VPROLQ $2, Z2, Z2
+	VPROLQ $61, Z18, Z18
+	VPROLQ $56, Z9, Z9
+	VPROLQ $14, Z20, Z20
+
+	// round 16: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z5, Z26
+	VPTERNLOGQ $0xd2, Z10, Z5, Z0
+	VPTERNLOGQ $0xd2, Z15, Z10, Z5
+	VPTERNLOGQ $0xd2, Z20, Z15, Z10
+	VPTERNLOGQ $0xd2, Z25, Z20, Z15
+	VPTERNLOGQ $0xd2, Z26, Z25, Z20
+	VMOVDQA64 Z23, Z25
+	VMOVDQA64 Z3, Z26
+	VPTERNLOGQ $0xd2, Z8, Z3, Z23
+	VPTERNLOGQ $0xd2, Z13, Z8, Z3
+	VPTERNLOGQ $0xd2, Z18, Z13, Z8
+	VPTERNLOGQ $0xd2, Z25, Z18, Z13
+	VPTERNLOGQ $0xd2, Z26, Z25, Z18
+	VMOVDQA64 Z16, Z25
+	VMOVDQA64 Z21, Z26
+	VPTERNLOGQ $0xd2, Z1, Z21, Z16
+	VPTERNLOGQ $0xd2, Z6, Z1, Z21
+	VPTERNLOGQ $0xd2, Z11, Z6, Z1
+	VPTERNLOGQ $0xd2, Z25, Z11, Z6
+	VPTERNLOGQ $0xd2, Z26, Z25, Z11
+	VMOVDQA64 Z14, Z25
+	VMOVDQA64 Z19, Z26
+	VPTERNLOGQ $0xd2, Z24, Z19, Z14
+	VPTERNLOGQ $0xd2, Z4, Z24, Z19
+	VPTERNLOGQ $0xd2, Z9, Z4, Z24
+	VPTERNLOGQ $0xd2, Z25, Z9, Z4
+	VPTERNLOGQ $0xd2, Z26, Z25, Z9
+	VMOVDQA64 Z7, Z25
+	VMOVDQA64 Z12, Z26
+	VPTERNLOGQ $0xd2, Z17, Z12, Z7
+	VPTERNLOGQ $0xd2, Z22, Z17, Z12
+	VPTERNLOGQ $0xd2, Z2, Z22, Z17
+	VPTERNLOGQ $0xd2, Z25, Z2, Z22
+	VPTERNLOGQ $0xd2, Z26, Z25, Z2
+	VPBROADCASTQ 128(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 17: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z16, Z23, Z25
+	VPTERNLOGQ $0x96, Z7, Z14, Z25
+	VMOVDQA64 Z5, Z26
+	VPTERNLOGQ $0x96, Z21, Z3, Z26
+	VPTERNLOGQ $0x96, Z12, Z19, Z26
+	VMOVDQA64 Z10, Z27
+	VPTERNLOGQ $0x96, Z1, Z8, Z27
+	VPTERNLOGQ $0x96, Z17, Z24, Z27
+	VMOVDQA64 Z15, Z28
+	VPTERNLOGQ $0x96, Z6, Z13, Z28
+	VPTERNLOGQ $0x96, Z22, Z4, Z28
+	VMOVDQA64 Z20, Z29
+	VPTERNLOGQ $0x96, Z11, Z18, Z29
+	VPTERNLOGQ $0x96, Z2, Z9, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z7, Z7
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z12, Z12
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z17, Z17
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z13, Z13
+	VPXORQ Z31, Z6, Z6
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z22, Z22
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z2, Z2
+
+	// round 17: ρ, π
+	VPROLQ $1, Z5, Z5
+	VPROLQ $62, Z10, Z10
+	VPROLQ $28, Z15, Z15
+	VPROLQ $27, Z20, Z20
+	VPROLQ $36, Z23, Z23
+	VPROLQ $44, Z3, Z3
+	VPROLQ $6, Z8, Z8
+	VPROLQ $55, Z13, Z13
+	VPROLQ $20, Z18, Z18
+	VPROLQ $3, Z16, Z16
+	VPROLQ $10, Z21, Z21
+	VPROLQ $43, Z1, Z1
+	VPROLQ $25, Z6, Z6
+	VPROLQ $39, Z11, Z11
+	VPROLQ $41, Z14, Z14
+	VPROLQ $45, Z19, Z19
+	VPROLQ $15, Z24, Z24
+	VPROLQ $21, Z4, Z4
+	VPROLQ $8, Z9, Z9
+	VPROLQ $18, Z7, Z7
+	VPROLQ $2, Z12, Z12
+	VPROLQ $61, Z17, Z17
+	VPROLQ $56, Z22, Z22
+	VPROLQ $14, Z2, Z2
+
+	// round 17: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z3, Z26
+	VPTERNLOGQ $0xd2, Z1, Z3, Z0
+	VPTERNLOGQ $0xd2, Z4, Z1, Z3
+	VPTERNLOGQ $0xd2, Z2, Z4, Z1
+	VPTERNLOGQ $0xd2, Z25, Z2, Z4
+	VPTERNLOGQ $0xd2, Z26, Z25, Z2
+	VMOVDQA64 Z15, Z25
+	VMOVDQA64 Z18, Z26
+	VPTERNLOGQ $0xd2, Z16, Z18, Z15
+	VPTERNLOGQ $0xd2, Z19, Z16, Z18
+	VPTERNLOGQ $0xd2, Z17, Z19, Z16
+	VPTERNLOGQ $0xd2, Z25, Z17, Z19
+	VPTERNLOGQ $0xd2, Z26, Z25, Z17
+	VMOVDQA64 Z5, Z25
+	VMOVDQA64 Z8, Z26
+	VPTERNLOGQ $0xd2, Z6, Z8, Z5
+	VPTERNLOGQ $0xd2, Z9, Z6, Z8
+	VPTERNLOGQ $0xd2, Z7, Z9, Z6
+	VPTERNLOGQ $0xd2, Z25, Z7, Z9
+	VPTERNLOGQ $0xd2, Z26, Z25, Z7
+	VMOVDQA64 Z20, Z25
+	VMOVDQA64 Z23, Z26
+	VPTERNLOGQ $0xd2, Z21, Z23, Z20
+	VPTERNLOGQ $0xd2, Z24, Z21, Z23
+	VPTERNLOGQ $0xd2, Z22, Z24, Z21
+	VPTERNLOGQ $0xd2, Z25, Z22, Z24
+	VPTERNLOGQ $0xd2, Z26, Z25, Z22
+	VMOVDQA64 Z10, Z25
+	VMOVDQA64 Z13, Z26
+	VPTERNLOGQ $0xd2, Z11, Z13, Z10
+	VPTERNLOGQ $0xd2, Z14, Z11, Z13
+	VPTERNLOGQ $0xd2, Z12, Z14, Z11
+	VPTERNLOGQ $0xd2, Z25, Z12, Z14
+	VPTERNLOGQ $0xd2, Z26, Z25, Z12
+	VPBROADCASTQ 136(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 18: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z5, Z15, Z25
+	VPTERNLOGQ $0x96, Z10, Z20, Z25
+	VMOVDQA64 Z3, Z26
+	VPTERNLOGQ $0x96, Z8, Z18, Z26
+	VPTERNLOGQ $0x96, Z13, Z23, Z26
+	VMOVDQA64 Z1, Z27
+	VPTERNLOGQ $0x96, Z6, Z16, Z27
+	VPTERNLOGQ $0x96, Z11, Z21, Z27
+	VMOVDQA64 Z4, Z28
+	VPTERNLOGQ $0x96, Z9, Z19, Z28
+	VPTERNLOGQ $0x96, Z14, Z24, Z28
+	VMOVDQA64 Z2, Z29
+	VPTERNLOGQ $0x96, Z7, Z17, Z29
+	VPTERNLOGQ $0x96, Z12, Z22, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z10, Z10
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z13, Z13
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z11, Z11
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z19, Z19
+	VPXORQ Z31, Z9, Z9
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z14, Z14
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z12, Z12
+
+	// round 18: ρ, π
+	VPROLQ $1, Z3, Z3
+	VPROLQ $62, Z1, Z1
+	VPROLQ $28, Z4, Z4
+	VPROLQ $27, Z2, Z2
+	VPROLQ $36, Z15, Z15
+	VPROLQ $44, Z18, Z18
+	VPROLQ $6, Z16, Z16
+	VPROLQ $55, Z19, Z19
+	VPROLQ $20, Z17, Z17
+	VPROLQ $3, Z5, Z5
+	VPROLQ $10, Z8, Z8
+	VPROLQ $43, Z6, Z6
+	VPROLQ $25, Z9, Z9
+	VPROLQ $39, Z7, Z7
+	VPROLQ $41, Z20, Z20
+	VPROLQ $45, Z23, Z23
+	VPROLQ $15, Z21, Z21
+	VPROLQ $21, Z24, Z24
+	VPROLQ $8, Z22, Z22
+	VPROLQ $18, Z10, Z10
+	VPROLQ $2, Z13, Z13
+	VPROLQ $61, Z11, Z11
+	VPROLQ $56, Z14, Z14
+	VPROLQ $14, Z12, Z12
+
+	// round 18: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z18, Z26
+	VPTERNLOGQ $0xd2, Z6, Z18, Z0
+	VPTERNLOGQ $0xd2, Z24, Z6, Z18
+	VPTERNLOGQ $0xd2, Z12, Z24, Z6
+	VPTERNLOGQ $0xd2, Z25, Z12, Z24
+	VPTERNLOGQ $0xd2, Z26, Z25, Z12
+	VMOVDQA64 Z4, Z25
+	VMOVDQA64 Z17, Z26
+	VPTERNLOGQ $0xd2, Z5, Z17, Z4
+	VPTERNLOGQ $0xd2, Z23, Z5, Z17
+	VPTERNLOGQ $0xd2, Z11, Z23, Z5
+	VPTERNLOGQ $0xd2, Z25, Z11, Z23
+	VPTERNLOGQ $0xd2, Z26, Z25, Z11
+	VMOVDQA64 Z3, Z25
+	VMOVDQA64 Z16, Z26
+	VPTERNLOGQ $0xd2, Z9, Z16, Z3
+	VPTERNLOGQ $0xd2, Z22, Z9, Z16
+	VPTERNLOGQ $0xd2, Z10, Z22, Z9
+	VPTERNLOGQ $0xd2, Z25, Z10, Z22
+	VPTERNLOGQ $0xd2, Z26, Z25, Z10
+	VMOVDQA64 Z2, Z25
+	VMOVDQA64 Z15, Z26
+	VPTERNLOGQ $0xd2, Z8, Z15, Z2
+	VPTERNLOGQ $0xd2, Z21, Z8, Z15
+	VPTERNLOGQ $0xd2, Z14, Z21, Z8
+	VPTERNLOGQ $0xd2, Z25, Z14, Z21
+	VPTERNLOGQ $0xd2, Z26, Z25, Z14
+	VMOVDQA64 Z1, Z25
+	VMOVDQA64 Z19, Z26
+	VPTERNLOGQ $0xd2, Z7, Z19, Z1
+	VPTERNLOGQ $0xd2, Z20, Z7, Z19
+	VPTERNLOGQ $0xd2, Z13, Z20, Z7
+	VPTERNLOGQ $0xd2, Z25, Z13, Z20
+	VPTERNLOGQ $0xd2, Z26, Z25, Z13
+	VPBROADCASTQ 144(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 19: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z3, Z4, Z25
+	VPTERNLOGQ $0x96, Z1, Z2, Z25
+	VMOVDQA64 Z18, Z26
+	VPTERNLOGQ $0x96, Z16, Z17, Z26
+	VPTERNLOGQ $0x96, Z19, Z15, Z26
+	VMOVDQA64 Z6, Z27
+	VPTERNLOGQ $0x96, Z9, Z5, Z27
+	VPTERNLOGQ $0x96, Z7, Z8, Z27
+	VMOVDQA64 Z24, Z28
+	VPTERNLOGQ $0x96, Z22, Z23, Z28
+	VPTERNLOGQ $0x96, Z20, Z21, Z28
+	VMOVDQA64 Z12, Z29
+	VPTERNLOGQ $0x96, Z10, Z11, Z29
+	VPTERNLOGQ $0x96, Z13, Z14, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z4, Z4
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z1, Z1
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z19, Z19
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z7, Z7
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z23, Z23
+	VPXORQ Z31, Z22, Z22
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z20, Z20
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z13, Z13
+
+	// round 19: ρ, π
+	VPROLQ $1, Z18, Z18
+	VPROLQ $62, Z6, Z6
+	VPROLQ $28, Z24, Z24
+	VPROLQ $27, Z12, Z12
+	VPROLQ $36, Z4, Z4
+	VPROLQ $44, Z17, Z17
+	VPROLQ $6, Z5, Z5
+	VPROLQ $55, Z23, Z23
+	VPROLQ $20, Z11, Z11
+	VPROLQ $3, Z3, Z3
+	VPROLQ $10, Z16, Z16
+	VPROLQ $43, Z9, Z9
+	VPROLQ $25, Z22, Z22
+	VPROLQ $39, Z10, Z10
+	VPROLQ $41, Z2, Z2
+	VPROLQ $45, Z15, Z15
+	VPROLQ $15, Z8, Z8
+	VPROLQ $21, Z21, Z21
+	VPROLQ $8, Z14, Z14
+	VPROLQ $18, Z1, Z1
+	VPROLQ $2, Z19, Z19
+	VPROLQ $61, Z7, Z7
+	VPROLQ $56, Z20, Z20
+	VPROLQ $14, Z13, Z13
+
+	// round 19: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z17, Z26
+	VPTERNLOGQ $0xd2, Z9, Z17, Z0
+	VPTERNLOGQ $0xd2, Z21, Z9, Z17
+	VPTERNLOGQ $0xd2, Z13, Z21, Z9
+	VPTERNLOGQ $0xd2, Z25, Z13, Z21
+	VPTERNLOGQ $0xd2, Z26, Z25, Z13
+	VMOVDQA64 Z24, Z25
+	VMOVDQA64 Z11, Z26
+	VPTERNLOGQ $0xd2, Z3, Z11, Z24
+	VPTERNLOGQ $0xd2, Z15, Z3, Z11
+	VPTERNLOGQ $0xd2, Z7, Z15, Z3
+	VPTERNLOGQ $0xd2, Z25, Z7, Z15
+	VPTERNLOGQ $0xd2, Z26, Z25, Z7
+	VMOVDQA64 Z18, Z25
+	VMOVDQA64 Z5, Z26
+	VPTERNLOGQ $0xd2, Z22, Z5, Z18
+	VPTERNLOGQ $0xd2, Z14, Z22, Z5
+	VPTERNLOGQ $0xd2, Z1, Z14, Z22
+	VPTERNLOGQ $0xd2, Z25, Z1, Z14
+	VPTERNLOGQ $0xd2, Z26, Z25, Z1
+	VMOVDQA64 Z12, Z25
+	VMOVDQA64 Z4, Z26
+	VPTERNLOGQ $0xd2, Z16, Z4, Z12
+	VPTERNLOGQ $0xd2, Z8, Z16, Z4
+	VPTERNLOGQ $0xd2, Z20, Z8, Z16
+	VPTERNLOGQ $0xd2, Z25, Z20, Z8
+	VPTERNLOGQ $0xd2, Z26, Z25, Z20
+	VMOVDQA64 Z6, Z25
+	VMOVDQA64 Z23, Z26
+	VPTERNLOGQ $0xd2, Z10, Z23, Z6
+	VPTERNLOGQ $0xd2, Z2, Z10, Z23
+	VPTERNLOGQ $0xd2, Z19, Z2, Z10
+	VPTERNLOGQ $0xd2, Z25, Z19, Z2
+	VPTERNLOGQ $0xd2, Z26, Z25, Z19
+	VPBROADCASTQ 152(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 20: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z18, Z24, Z25
+	VPTERNLOGQ $0x96, Z6, Z12, Z25
+	VMOVDQA64 Z17, Z26
+	VPTERNLOGQ $0x96, Z5, Z11, Z26
+	VPTERNLOGQ $0x96, Z23, Z4, Z26
+	VMOVDQA64 Z9, Z27
+	VPTERNLOGQ $0x96, Z22, Z3, Z27
+	VPTERNLOGQ $0x96, Z10, Z16, Z27
+	VMOVDQA64 Z21, Z28
+	VPTERNLOGQ $0x96, Z14, Z15, Z28
+	VPTERNLOGQ $0x96, Z2, Z8, Z28
+	VMOVDQA64 Z13, Z29
+	VPTERNLOGQ $0x96, Z1, Z7, Z29
+	VPTERNLOGQ $0x96, Z19, Z20, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z24, Z24
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z6, Z6
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z23, Z23
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z10, Z10
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z15, Z15
+	VPXORQ Z31, Z14, Z14
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z2, Z2
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z19, Z19
+
+	// round 20: ρ, π
+	VPROLQ $1, Z17, Z17
+	VPROLQ $62, Z9, Z9
+	VPROLQ $28, Z21, Z21
+	VPROLQ $27, Z13, Z13
+	VPROLQ $36, Z24, Z24
+	VPROLQ $44, Z11, Z11
+	VPROLQ $6, Z3, Z3
+	VPROLQ $55, Z15, Z15
+	VPROLQ $20, Z7, Z7
+	VPROLQ $3, Z18, Z18
+	VPROLQ $10, Z5, Z5
+	VPROLQ $43, Z22, Z22
+	VPROLQ $25, Z14, Z14
+	VPROLQ $39, Z1, Z1
+	VPROLQ $41, Z12, Z12
+	VPROLQ $45, Z4, Z4
+	VPROLQ $15, Z16, Z16
+	VPROLQ $21, Z8, Z8
+	VPROLQ $8, Z20, Z20
+	VPROLQ $18, Z6, Z6
+	VPROLQ $2, Z23, Z23
+	VPROLQ $61, Z10, Z10
+	VPROLQ $56, Z2, Z2
+	VPROLQ $14, Z19, Z19
+
+	// round 20: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z11, Z26
+	VPTERNLOGQ $0xd2, Z22, Z11, Z0
+	VPTERNLOGQ $0xd2, Z8, Z22, Z11
+	VPTERNLOGQ $0xd2, Z19, Z8, Z22
+	VPTERNLOGQ $0xd2, Z25, Z19, Z8
+	VPTERNLOGQ $0xd2, Z26, Z25, Z19
+	VMOVDQA64 Z21, Z25
+	VMOVDQA64 Z7, Z26
+	VPTERNLOGQ $0xd2, Z18, Z7, Z21
+	VPTERNLOGQ $0xd2, Z4, Z18, Z7
+	VPTERNLOGQ $0xd2, Z10, Z4, Z18
+	VPTERNLOGQ $0xd2, Z25, Z10, Z4
+	VPTERNLOGQ $0xd2, Z26, Z25, Z10
+	VMOVDQA64 Z17, Z25
+	VMOVDQA64 Z3, Z26
+	VPTERNLOGQ $0xd2, Z14, Z3, Z17
+	VPTERNLOGQ $0xd2, Z20, Z14, Z3
+	VPTERNLOGQ $0xd2, Z6, Z20, Z14
+	VPTERNLOGQ $0xd2, Z25, Z6, Z20
+	VPTERNLOGQ $0xd2, Z26, Z25, Z6
+	VMOVDQA64 Z13, Z25
+	VMOVDQA64 Z24, Z26
+	VPTERNLOGQ $0xd2, Z5, Z24, Z13
+	VPTERNLOGQ $0xd2, Z16, Z5, Z24
+	VPTERNLOGQ $0xd2, Z2, Z16, Z5
+	VPTERNLOGQ $0xd2, Z25, Z2, Z16
+	VPTERNLOGQ $0xd2, Z26, Z25, Z2
+	VMOVDQA64 Z9, Z25
+	VMOVDQA64 Z15, Z26
+	VPTERNLOGQ $0xd2, Z1, Z15, Z9
+	VPTERNLOGQ $0xd2, Z12, Z1, Z15
+	VPTERNLOGQ $0xd2, Z23, Z12, Z1
+	VPTERNLOGQ $0xd2, Z25, Z23, Z12
+	VPTERNLOGQ $0xd2, Z26, Z25, Z23
+	VPBROADCASTQ 160(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 21: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z17, Z21, Z25
+	VPTERNLOGQ $0x96, Z9, Z13, Z25
+	VMOVDQA64 Z11, Z26
+	VPTERNLOGQ $0x96, Z3, Z7, Z26
+	VPTERNLOGQ $0x96, Z15, Z24, Z26
+	VMOVDQA64 Z22, Z27
+	VPTERNLOGQ $0x96, Z14, Z18, Z27
+	VPTERNLOGQ $0x96, Z1, Z5, Z27
+	VMOVDQA64 Z8, Z28
+	VPTERNLOGQ $0x96, Z20, Z4, Z28
+	VPTERNLOGQ $0x96, Z12, Z16, Z28
+	VMOVDQA64 Z19, Z29
+	VPTERNLOGQ $0x96, Z6, Z10, Z29
+	VPTERNLOGQ $0x96, Z23, Z2, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z21, Z21
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z9, Z9
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z11, Z11
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z15, Z15
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z5, Z5
+	VPXORQ Z30, Z1, Z1
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z4, Z4
+	VPXORQ Z31, Z20, Z20
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z12, Z12
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z10, Z10
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z23, Z23
+
+	// round 21: ρ, π
+	VPROLQ $1, Z11, Z11
+	VPROLQ $62, Z22, Z22
+	VPROLQ $28, Z8, Z8
+	VPROLQ $27, Z19, Z19
+	VPROLQ $36, Z21, Z21
+	VPROLQ $44, Z7, Z7
+	VPROLQ $6, Z18, Z18
+	VPROLQ $55, Z4, Z4
+	VPROLQ $20, Z10, Z10
+	VPROLQ $3, Z17, Z17
+	VPROLQ $10, Z3, Z3
+	VPROLQ $43, Z14, Z14
+	VPROLQ $25, Z20, Z20
+	VPROLQ $39, Z6, Z6
+	VPROLQ $41, Z13, Z13
+	VPROLQ $45, Z24, Z24
+	VPROLQ $15, Z5, Z5
+	VPROLQ $21, Z16, Z16
+	VPROLQ $8, Z2, Z2
+	VPROLQ $18, Z9, Z9
+	VPROLQ $2, Z15, Z15
+	VPROLQ $61, Z1, Z1
+	VPROLQ $56, Z12, Z12
+	VPROLQ $14, Z23, Z23
+
+	// round 21: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z7, Z26
+	VPTERNLOGQ $0xd2, Z14, Z7, Z0
+	VPTERNLOGQ $0xd2, Z16, Z14, Z7
+	VPTERNLOGQ $0xd2, Z23, Z16, Z14
+	VPTERNLOGQ $0xd2, Z25, Z23, Z16
+	VPTERNLOGQ $0xd2, Z26, Z25, Z23
+	VMOVDQA64 Z8, Z25
+	VMOVDQA64 Z10, Z26
+	VPTERNLOGQ $0xd2, Z17, Z10, Z8
+	VPTERNLOGQ $0xd2, Z24, Z17, Z10
+	VPTERNLOGQ $0xd2, Z1, Z24, Z17
+	VPTERNLOGQ $0xd2, Z25, Z1, Z24
+	VPTERNLOGQ $0xd2, Z26, Z25, Z1
+	VMOVDQA64 Z11, Z25
+	VMOVDQA64 Z18, Z26
+	VPTERNLOGQ $0xd2, Z20, Z18, Z11
+	VPTERNLOGQ $0xd2, Z2, Z20, Z18
+	VPTERNLOGQ $0xd2, Z9, Z2, Z20
+	VPTERNLOGQ $0xd2, Z25, Z9, Z2
+	VPTERNLOGQ $0xd2, Z26, Z25, Z9
+	VMOVDQA64 Z19, Z25
+	VMOVDQA64 Z21, Z26
+	VPTERNLOGQ $0xd2, Z3, Z21, Z19
+	VPTERNLOGQ $0xd2, Z5, Z3, Z21
+	VPTERNLOGQ $0xd2, Z12, Z5, Z3
+	VPTERNLOGQ $0xd2, Z25, Z12, Z5
+	VPTERNLOGQ $0xd2, Z26, Z25, Z12
+	VMOVDQA64 Z22, Z25
+	VMOVDQA64 Z4, Z26
+	VPTERNLOGQ $0xd2, Z6, Z4, Z22
+	VPTERNLOGQ $0xd2, Z13, Z6, Z4
+	VPTERNLOGQ $0xd2, Z15, Z13, Z6
+	VPTERNLOGQ $0xd2, Z25, Z15, Z13
+	VPTERNLOGQ $0xd2, Z26, Z25, Z15
+	VPBROADCASTQ 168(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 22: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z11, Z8, Z25
+	VPTERNLOGQ $0x96, Z22, Z19, Z25
+	VMOVDQA64 Z7, Z26
+	VPTERNLOGQ $0x96, Z18, Z10, Z26
+	VPTERNLOGQ $0x96, Z4, Z21, Z26
+	VMOVDQA64 Z14, Z27
+	VPTERNLOGQ $0x96, Z20, Z17, Z27
+	VPTERNLOGQ $0x96, Z6, Z3, Z27
+	VMOVDQA64 Z16, Z28
+	VPTERNLOGQ $0x96, Z2, Z24, Z28
+	VPTERNLOGQ $0x96, Z13, Z5, Z28
+	VMOVDQA64 Z23, Z29
+	VPTERNLOGQ $0x96, Z9, Z1, Z29
+	VPTERNLOGQ $0x96, Z15, Z12, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z8, Z8
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z19, Z19
+	VPXORQ Z30, Z22, Z22
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z7, Z7
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z18, Z18
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z4, Z4
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z14, Z14
+	VPXORQ Z30, Z17, Z17
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z3, Z3
+	VPXORQ Z30, Z6, Z6
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z16, Z16
+	VPXORQ Z31, Z24, Z24
+	VPXORQ Z31, Z2, Z2
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z13, Z13
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z1, Z1
+	VPXORQ Z30, Z9, Z9
+	VPXORQ Z30, Z12, Z12
+	VPXORQ Z30, Z15, Z15
+
+	// round 22: ρ, π
+	VPROLQ $1, Z7, Z7
+	VPROLQ $62, Z14, Z14
+	VPROLQ $28, Z16, Z16
+	VPROLQ $27, Z23, Z23
+	VPROLQ $36, Z8, Z8
+	VPROLQ $44, Z10, Z10
+	VPROLQ $6, Z17, Z17
+	VPROLQ $55, Z24, Z24
+	VPROLQ $20, Z1, Z1
+	VPROLQ $3, Z11, Z11
+	VPROLQ $10, Z18, Z18
+	VPROLQ $43, Z20, Z20
+	VPROLQ $25, Z2, Z2
+	VPROLQ $39, Z9, Z9
+	VPROLQ $41, Z19, Z19
+	VPROLQ $45, Z21, Z21
+	VPROLQ $15, Z3, Z3
+	VPROLQ $21, Z5, Z5
+	VPROLQ $8, Z12, Z12
+	VPROLQ $18, Z22, Z22
+	VPROLQ $2, Z4, Z4
+	VPROLQ $61, Z6, Z6
+	VPROLQ $56, Z13, Z13
+	VPROLQ $14, Z15, Z15
+
+	// round 22: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z10, Z26
+	VPTERNLOGQ $0xd2, Z20, Z10, Z0
+	VPTERNLOGQ $0xd2, Z5, Z20, Z10
+	VPTERNLOGQ $0xd2, Z15, Z5, Z20
+	VPTERNLOGQ $0xd2, Z25, Z15, Z5
+	VPTERNLOGQ $0xd2, Z26, Z25, Z15
+	VMOVDQA64 Z16, Z25
+	VMOVDQA64 Z1, Z26
+	VPTERNLOGQ $0xd2, Z11, Z1, Z16
+	VPTERNLOGQ $0xd2, Z21, Z11, Z1
+	VPTERNLOGQ $0xd2, Z6, Z21, Z11
+	VPTERNLOGQ $0xd2, Z25, Z6, Z21
+	VPTERNLOGQ $0xd2, Z26, Z25, Z6
+	VMOVDQA64 Z7, Z25
+	VMOVDQA64 Z17, Z26
+	VPTERNLOGQ $0xd2, Z2, Z17, Z7
+	VPTERNLOGQ $0xd2, Z12, Z2, Z17
+	VPTERNLOGQ $0xd2, Z22, Z12, Z2
+	VPTERNLOGQ $0xd2, Z25, Z22, Z12
+	VPTERNLOGQ $0xd2, Z26, Z25, Z22
+	VMOVDQA64 Z23, Z25
+	VMOVDQA64 Z8, Z26
+	VPTERNLOGQ $0xd2, Z18, Z8, Z23
+	VPTERNLOGQ $0xd2, Z3, Z18, Z8
+	VPTERNLOGQ $0xd2, Z13, Z3, Z18
+	VPTERNLOGQ $0xd2, Z25, Z13, Z3
+	VPTERNLOGQ $0xd2, Z26, Z25, Z13
+	VMOVDQA64 Z14, Z25
+	VMOVDQA64 Z24, Z26
+	VPTERNLOGQ $0xd2, Z9, Z24, Z14
+	VPTERNLOGQ $0xd2, Z19, Z9, Z24
+	VPTERNLOGQ $0xd2, Z4, Z19, Z9
+	VPTERNLOGQ $0xd2, Z25, Z4, Z19
+	VPTERNLOGQ $0xd2, Z26, Z25, Z4
+	VPBROADCASTQ 176(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// round 23: θ
+	VMOVDQA64 Z0, Z25
+	VPTERNLOGQ $0x96, Z7, Z16, Z25
+	VPTERNLOGQ $0x96, Z14, Z23, Z25
+	VMOVDQA64 Z10, Z26
+	VPTERNLOGQ $0x96, Z17, Z1, Z26
+	VPTERNLOGQ $0x96, Z24, Z8, Z26
+	VMOVDQA64 Z20, Z27
+	VPTERNLOGQ $0x96, Z2, Z11, Z27
+	VPTERNLOGQ $0x96, Z9, Z18, Z27
+	VMOVDQA64 Z5, Z28
+	VPTERNLOGQ $0x96, Z12, Z21, Z28
+	VPTERNLOGQ $0x96, Z19, Z3, Z28
+	VMOVDQA64 Z15, Z29
+	VPTERNLOGQ $0x96, Z22, Z6, Z29
+	VPTERNLOGQ $0x96, Z4, Z13, Z29
+	VPROLQ $1, Z26, Z30
+	VPXORQ Z29, Z30, Z30
+	VPXORQ Z30, Z0, Z0
+	VPXORQ Z30, Z16, Z16
+	VPXORQ Z30, Z7, Z7
+	VPXORQ Z30, Z23, Z23
+	VPXORQ Z30, Z14, Z14
+	VPROLQ $1, Z27, Z31
+	VPXORQ Z25, Z31, Z31
+	VPXORQ Z31, Z10, Z10
+	VPXORQ Z31, Z1, Z1
+	VPXORQ Z31, Z17, Z17
+	VPXORQ Z31, Z8, Z8
+	VPXORQ Z31, Z24, Z24
+	VPROLQ $1, Z28, Z30
+	VPXORQ Z26, Z30, Z30
+	VPXORQ Z30, Z20, Z20
+	VPXORQ Z30, Z11, Z11
+	VPXORQ Z30, Z2, Z2
+	VPXORQ Z30, Z18, Z18
+	VPXORQ Z30, Z9, Z9
+	VPROLQ $1, Z29, Z31
+	VPXORQ Z27, Z31, Z31
+	VPXORQ Z31, Z5, Z5
+	VPXORQ Z31, Z21, Z21
+	VPXORQ Z31, Z12, Z12
+	VPXORQ Z31, Z3, Z3
+	VPXORQ Z31, Z19, Z19
+	VPROLQ $1, Z25, Z30
+	VPXORQ Z28, Z30, Z30
+	VPXORQ Z30, Z15, Z15
+	VPXORQ Z30, Z6, Z6
+	VPXORQ Z30, Z22, Z22
+	VPXORQ Z30, Z13, Z13
+	VPXORQ Z30, Z4, Z4
+
+	// round 23: ρ, π
+	VPROLQ $1, Z10, Z10
+	VPROLQ $62, Z20, Z20
+	VPROLQ $28, Z5, Z5
+	VPROLQ $27, Z15, Z15
+	VPROLQ $36, Z16, Z16
+	VPROLQ $44, Z1, Z1
+	VPROLQ $6, Z11, Z11
+	VPROLQ $55, Z21, Z21
+	VPROLQ $20, Z6, Z6
+	VPROLQ $3, Z7, Z7
+	VPROLQ $10, Z17, Z17
+	VPROLQ $43, Z2, Z2
+	VPROLQ $25, Z12, Z12
+	VPROLQ $39, Z22, Z22
+	VPROLQ $41, Z23, Z23
+	VPROLQ $45, Z8, Z8
+	VPROLQ $15, Z18, Z18
+	VPROLQ $21, Z3, Z3
+	VPROLQ $8, Z13, Z13
+	VPROLQ $18, Z14, Z14
+	VPROLQ $2, Z24, Z24
+	VPROLQ $61, Z9, Z9
+	VPROLQ $56, Z19, Z19
+	VPROLQ $14, Z4, Z4
+
+	// round 23: χ, ι
+	VMOVDQA64 Z0, Z25
+	VMOVDQA64 Z1, Z26
+	VPTERNLOGQ $0xd2, Z2, Z1, Z0
+	VPTERNLOGQ $0xd2, Z3, Z2, Z1
+	VPTERNLOGQ $0xd2, Z4, Z3, Z2
+	VPTERNLOGQ $0xd2, Z25, Z4, Z3
+	VPTERNLOGQ $0xd2, Z26, Z25, Z4
+	VMOVDQA64 Z5, Z25
+	VMOVDQA64 Z6, Z26
+	VPTERNLOGQ $0xd2, Z7, Z6, Z5
+	VPTERNLOGQ $0xd2, Z8, Z7, Z6
+	VPTERNLOGQ $0xd2, Z9, Z8, Z7
+	VPTERNLOGQ $0xd2, Z25, Z9, Z8
+	VPTERNLOGQ $0xd2, Z26, Z25, Z9
+	VMOVDQA64 Z10, Z25
+	VMOVDQA64 Z11, Z26
+	VPTERNLOGQ $0xd2, Z12, Z11, Z10
+	VPTERNLOGQ $0xd2, Z13, Z12, Z11
+	VPTERNLOGQ $0xd2, Z14, Z13, Z12
+	VPTERNLOGQ $0xd2, Z25, Z14, Z13
+	VPTERNLOGQ $0xd2, Z26, Z25, Z14
+	VMOVDQA64 Z15, Z25
+	VMOVDQA64 Z16, Z26
+	VPTERNLOGQ $0xd2, Z17, Z16, Z15
+	VPTERNLOGQ $0xd2, Z18, Z17, Z16
+	VPTERNLOGQ $0xd2, Z19, Z18, Z17
+	VPTERNLOGQ $0xd2, Z25, Z19, Z18
+	VPTERNLOGQ $0xd2, Z26, Z25, Z19
+	VMOVDQA64 Z20, Z25
+	VMOVDQA64 Z21, Z26
+	VPTERNLOGQ $0xd2, Z22, Z21, Z20
+	VPTERNLOGQ $0xd2, Z23, Z22, Z21
+	VPTERNLOGQ $0xd2, Z24, Z23, Z22
+	VPTERNLOGQ $0xd2, Z25, Z24, Z23
+	VPTERNLOGQ $0xd2, Z26, Z25, Z24
+	VPBROADCASTQ 184(BX), Z27
+	VPXORQ Z27, Z0, Z0
+
+	// store
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 64(AX)
+	VMOVDQU64 Z2, 128(AX)
+	VMOVDQU64 Z3, 192(AX)
+	VMOVDQU64 Z4, 256(AX)
+	VMOVDQU64 Z5, 320(AX)
+	VMOVDQU64 Z6, 384(AX)
+	VMOVDQU64 Z7, 448(AX)
+	VMOVDQU64 Z8, 512(AX)
+	VMOVDQU64 Z9, 576(AX)
+	VMOVDQU64 Z10, 640(AX)
+	VMOVDQU64 Z11, 704(AX)
+	VMOVDQU64 Z12, 768(AX)
+	VMOVDQU64 Z13, 832(AX)
+	VMOVDQU64 Z14, 896(AX)
+	VMOVDQU64 Z15, 960(AX)
+	VMOVDQU64 Z16, 1024(AX)
+	VMOVDQU64 Z17, 1088(AX)
+	VMOVDQU64 Z18, 1152(AX)
+	VMOVDQU64 Z19, 1216(AX)
+	VMOVDQU64 Z20, 1280(AX)
+	VMOVDQU64 Z21, 1344(AX)
+	VMOVDQU64 Z22, 1408(AX)
+	VMOVDQU64 Z23, 1472(AX)
+	VMOVDQU64 Z24, 1536(AX)
+	VZEROUPPER
+	RET
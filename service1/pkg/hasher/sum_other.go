@@ -0,0 +1,9 @@
+//go:build !amd64 || purego
+
+package hasher
+
+// Без ассемблерных ядер все строки хешируются по одной.
+var (
+	sha256Backends []*sha256MB
+	keccakBackends []*keccakMB
+)
@@ -116,6 +116,62 @@ func (x *HashResponse) GetHashes() []string {
 	return nil
 }
 
+// Кусок данных текущего элемента; last = true завершает элемент
+type HashChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Last bool   `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+}
+
+func (x *HashChunk) Reset() {
+	*x = HashChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_hash_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashChunk) ProtoMessage() {}
+
+func (x *HashChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hash_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashChunk.ProtoReflect.Descriptor instead.
+func (*HashChunk) Descriptor() ([]byte, []int) {
+	return file_proto_hash_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HashChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HashChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
 var File_proto_hash_proto protoreflect.FileDescriptor
 
 var file_proto_hash_proto_rawDesc = []byte{
@@ -125,14 +181,21 @@ var file_proto_hash_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69,
 	0x6e, 0x67, 0x73, 0x22, 0x26, 0x0a, 0x0c, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x32, 0x4d, 0x0a, 0x0d, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0f,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
-	0x13, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x61,
-	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x10, 0x5a, 0x0e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x09, 0x48,
+	0x61, 0x73, 0x68, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74,
+	0x32, 0x91, 0x01, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2e, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x14, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x42, 0x10, 0x5a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -147,16 +210,19 @@ func file_proto_hash_proto_rawDescGZIP() []byte {
 	return file_proto_hash_proto_rawDescData
 }
 
-var file_proto_hash_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_hash_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_proto_hash_proto_goTypes = []interface{}{
 	(*HashRequest)(nil),  // 0: hasher.HashRequest
 	(*HashResponse)(nil), // 1: hasher.HashResponse
+	(*HashChunk)(nil),    // 2: hasher.HashChunk
 }
 var file_proto_hash_proto_depIdxs = []int32{
 	0, // 0: hasher.HasherService.CalculateHashes:input_type -> hasher.HashRequest
-	1, // 1: hasher.HasherService.CalculateHashes:output_type -> hasher.HashResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: hasher.HasherService.CalculateHashesStream:input_type -> hasher.HashChunk
+	1, // 2: hasher.HasherService.CalculateHashes:output_type -> hasher.HashResponse
+	1, // 3: hasher.HasherService.CalculateHashesStream:output_type -> hasher.HashResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -192,6 +258,18 @@ func file_proto_hash_proto_init() {
 				return nil
 			}
 		}
+		file_proto_hash_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -199,7 +277,7 @@ func file_proto_hash_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_hash_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
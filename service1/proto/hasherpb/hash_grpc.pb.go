@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	HasherService_CalculateHashes_FullMethodName = "/hasher.HasherService/CalculateHashes"
+	HasherService_CalculateHashes_FullMethodName       = "/hasher.HasherService/CalculateHashes"
+	HasherService_CalculateHashesStream_FullMethodName = "/hasher.HasherService/CalculateHashesStream"
 )
 
 // HasherServiceClient is the client API for HasherService service.
@@ -28,6 +29,8 @@ const (
 type HasherServiceClient interface {
 	// Получить список хэшей от переданных строк
 	CalculateHashes(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*HashResponse, error)
+	// Получить список хэшей от бинарных данных, переданных потоком чанков
+	CalculateHashesStream(ctx context.Context, opts ...grpc.CallOption) (HasherService_CalculateHashesStreamClient, error)
 }
 
 type hasherServiceClient struct {
@@ -48,12 +51,49 @@ func (c *hasherServiceClient) CalculateHashes(ctx context.Context, in *HashReque
 	return out, nil
 }
 
+func (c *hasherServiceClient) CalculateHashesStream(ctx context.Context, opts ...grpc.CallOption) (HasherService_CalculateHashesStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HasherService_ServiceDesc.Streams[0], HasherService_CalculateHashesStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hasherServiceCalculateHashesStreamClient{ClientStream: stream}
+	return x, nil
+}
+
+type HasherService_CalculateHashesStreamClient interface {
+	Send(*HashChunk) error
+	CloseAndRecv() (*HashResponse, error)
+	grpc.ClientStream
+}
+
+type hasherServiceCalculateHashesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *hasherServiceCalculateHashesStreamClient) Send(m *HashChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *hasherServiceCalculateHashesStreamClient) CloseAndRecv() (*HashResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(HashResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HasherServiceServer is the server API for HasherService service.
 // All implementations must embed UnimplementedHasherServiceServer
 // for forward compatibility
 type HasherServiceServer interface {
 	// Получить список хэшей от переданных строк
 	CalculateHashes(context.Context, *HashRequest) (*HashResponse, error)
+	// Получить список хэшей от бинарных данных, переданных потоком чанков
+	CalculateHashesStream(HasherService_CalculateHashesStreamServer) error
 	mustEmbedUnimplementedHasherServiceServer()
 }
 
@@ -64,6 +104,9 @@ type UnimplementedHasherServiceServer struct {
 func (UnimplementedHasherServiceServer) CalculateHashes(context.Context, *HashRequest) (*HashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateHashes not implemented")
 }
+func (UnimplementedHasherServiceServer) CalculateHashesStream(HasherService_CalculateHashesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CalculateHashesStream not implemented")
+}
 func (UnimplementedHasherServiceServer) mustEmbedUnimplementedHasherServiceServer() {}
 
 // UnsafeHasherServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -95,6 +138,32 @@ func _HasherService_CalculateHashes_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HasherService_CalculateHashesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HasherServiceServer).CalculateHashesStream(&hasherServiceCalculateHashesStreamServer{ServerStream: stream})
+}
+
+type HasherService_CalculateHashesStreamServer interface {
+	SendAndClose(*HashResponse) error
+	Recv() (*HashChunk, error)
+	grpc.ServerStream
+}
+
+type hasherServiceCalculateHashesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *hasherServiceCalculateHashesStreamServer) SendAndClose(m *HashResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *hasherServiceCalculateHashesStreamServer) Recv() (*HashChunk, error) {
+	m := new(HashChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HasherService_ServiceDesc is the grpc.ServiceDesc for HasherService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +176,12 @@ var HasherService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _HasherService_CalculateHashes_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CalculateHashesStream",
+			Handler:       _HasherService_CalculateHashesStream_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "proto/hash.proto",
 }
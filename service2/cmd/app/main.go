@@ -8,15 +8,22 @@ import (
 	"os"
 	"os/signal"
 	"service2/internal/config"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"service2/internal/api"
+	"service2/internal/cache"
 	"service2/internal/grpcclient"
+	"service2/internal/health"
 	"service2/internal/mw"
 	"service2/internal/storage"
+	"service2/internal/tlsconf"
 )
 
 func main() {
@@ -34,6 +41,8 @@ func main() {
 			Error("consul init error")
 		return
 	}
+	level, _ := logrus.ParseLevel(appCfg.LogLevel)
+	logg.SetLevel(level)
 
 	store, err := storage.New(rootCtx, appCfg.DBDSN)
 	if err != nil {
@@ -43,8 +52,74 @@ func main() {
 		return
 	}
 	defer store.Close()
+	store.RLS = appCfg.DBRLS
 
-	hashCl, err := grpcclient.New(fmt.Sprintf("service1:%s", appCfg.HasherPort))
+	// с db_rls политика hashes действует, только если роль её не обходит
+	if appCfg.DBRLS {
+		bypass, err := store.BypassesRLS(rootCtx)
+		if err != nil {
+			werr := errors.WithStack(err)
+			logg.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("db role check failed")
+			return
+		}
+		if bypass {
+			logg.Error("db_rls is set, but the database role is a superuser or has BYPASSRLS and would see every tenant; connect as another role")
+			return
+		}
+	}
+
+	var grpcCreds credentials.TransportCredentials
+	if appCfg.GRPCTLSCA != "" || appCfg.GRPCTLSCert != "" {
+		certs, err := tlsconf.NewReloader(tlsconf.Files{
+			Cert: appCfg.GRPCTLSCert,
+			Key:  appCfg.GRPCTLSKey,
+			CA:   appCfg.GRPCTLSCA,
+		}, logg)
+		if err != nil {
+			werr := errors.WithStack(err)
+			logg.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("grpc tls init failed")
+			return
+		}
+		go certs.Watch(rootCtx, 30*time.Second)
+		grpcCreds = credentials.NewTLS(certs.ClientConfig(appCfg.GRPCTLSServerName))
+	} else {
+		logg.Warn("grpc tls is disabled, service1 is called in plaintext")
+	}
+
+	// брейкер снаружи повторов: серия повторов — один вызов для него. Подпись
+	// добавляется внутри, заново для каждой попытки
+	breaker := grpcclient.NewBreaker(appCfg.GRPCBreakerFailures, appCfg.GRPCBreakerCooldown)
+	grpcOpts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(
+			grpcclient.UnaryClientBreaker(breaker),
+			grpcclient.UnaryClientRetry(grpcclient.RetryPolicy{
+				MaxAttempts: appCfg.GRPCRetryAttempts,
+				Backoff:     appCfg.GRPCRetryBackoff,
+				MaxBackoff:  appCfg.GRPCRetryMaxBackoff,
+			}),
+			grpcclient.UnaryClientTimeout(appCfg.GRPCTimeout),
+		),
+		grpc.WithChainStreamInterceptor(grpcclient.StreamClientBreaker(breaker)),
+	}
+	if appCfg.GRPCToken != "" || appCfg.GRPCHMACSecret != "" {
+		cr := grpcclient.Credentials{ClientID: appCfg.GRPCClientID, Token: appCfg.GRPCToken, HMACSecret: appCfg.GRPCHMACSecret}
+		grpcOpts = append(grpcOpts,
+			grpc.WithChainUnaryInterceptor(grpcclient.UnaryClientAuth(cr)),
+			grpc.WithChainStreamInterceptor(grpcclient.StreamClientAuth(cr)),
+		)
+	}
+
+	hasherTarget := fmt.Sprintf("service1:%s", appCfg.HasherPort)
+	if appCfg.GRPCDiscovery == "consul" {
+		var discoveryOpts []grpc.DialOption
+		hasherTarget, discoveryOpts = grpcclient.Consul("consul:8500", "service1")
+		grpcOpts = append(grpcOpts, discoveryOpts...)
+	}
+	logg.WithField("target", hasherTarget).Info("service1 client target")
+
+	hashCl, err := grpcclient.New(hasherTarget, grpcCreds, grpcOpts...)
 	defer hashCl.Close()
 
 	if err != nil {
@@ -53,17 +128,132 @@ func main() {
 			Fatal("grpc client failed")
 	}
 
+	// без Redis API работает дальше: кэш выключается, лимиты считаются в
+	// памяти, а соединение восстанавливается в фоне
 	rdb := redis.NewClient(&redis.Options{Addr: appCfg.RedisAddr})
-	if err := rdb.Ping(rootCtx).Err(); err != nil {
-		werr := errors.WithStack(err)
-		logg.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
-			Error("redis connect failed")
-		return
-	}
 	defer rdb.Close()
+	redisCache := cache.NewRedis(rdb)
+	guarded := cache.NewGuarded(rootCtx, redisCache, redisCache.Ping, logg)
+	// удаления, пропущенные при недоступном Redis, нельзя повторить точно:
+	// после них кэш хешей очищается целиком
+	guarded.Purge = func(ctx context.Context) error {
+		return redisCache.DelPrefix(ctx, api.CachePrefix)
+	}
+	go guarded.Run(rootCtx)
+	var hashCache cache.Cache = guarded
+	if appCfg.CacheWriteQueue > 0 {
+		async := cache.NewAsync(guarded, appCfg.CacheWriteQueue, logg)
+		defer async.Close()
+		hashCache = async
+	}
+	if appCfg.CacheLocalSize > 0 {
+		tiered := cache.NewTiered(hashCache, rdb, appCfg.CacheLocalSize, appCfg.CacheLocalTTL, logg)
+		go tiered.Run(rootCtx)
+		hashCache = tiered
+	}
 
-	h := &api.Handlers{HashClient: hashCl, Store: store, Log: logg, Cache: rdb, CacheTTL: appCfg.CacheTTL}
-	r := api.NewRouter(h, logg)
+	// с ключом запоминание можно включать и выключать через memo_ttl на ходу
+	var memo *grpcclient.Memo
+	if appCfg.MemoKey != "" {
+		memo = grpcclient.NewMemo(hashCl, hashCache, []byte(appCfg.MemoKey), appCfg.HashAlgorithm, logg)
+		memo.SetTTL(appCfg.MemoTTL)
+		memo.MaxBytes = appCfg.MemoMaxBytes
+		go memo.Run(rootCtx, 10*time.Second)
+		hashCl = memo
+	}
+
+	var active atomic.Pointer[config.AppConfig]
+	active.Store(appCfg)
+	h := &api.Handlers{HashClient: hashCl, Store: store, Log: logg, Cache: hashCache,
+		Limits: api.Limits{
+			MaxBodyBytes:   appCfg.MaxBodyBytes,
+			MaxUploadBytes: appCfg.MaxUploadBytes,
+			MaxItems:       appCfg.MaxItems,
+			MaxItemBytes:   appCfg.MaxItemBytes,
+			BatchItems:     appCfg.GRPCMaxItems,
+		},
+		Config: func() map[string]any { return active.Load().Redacted() }}
+	h.SetCacheTTL(appCfg.CacheTTL)
+
+	apiKeys := &mw.APIKeys{Lookup: h.LookupAPIKey}
+	if appCfg.AdminKey != "" {
+		apiKeys.Static = map[string]mw.Principal{
+			mw.HashAPIKey(appCfg.AdminKey): {Tenant: storage.DefaultTenant, Subject: "admin-key", Scopes: []string{mw.ScopeAdmin}},
+		}
+	}
+	authenticators := []mw.Authenticator{apiKeys}
+	if appCfg.OIDCJWKS != "" {
+		keys, err := mw.NewKeySet(appCfg.OIDCJWKS)
+		if err != nil {
+			werr := errors.WithStack(err)
+			logg.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("jwks load failed")
+			return
+		}
+		authenticators = append(authenticators, &mw.JWT{
+			Keys:        keys,
+			Issuer:      appCfg.OIDCIssuer,
+			Audience:    appCfg.OIDCAudience,
+			TenantClaim: appCfg.OIDCTenantClaim,
+		})
+	}
+	if !appCfg.AuthEnabled {
+		logg.Warn("authentication is disabled, all requests run as the default tenant and admin routes are not served")
+	}
+	// анонимный клиент только пишет и читает хеши: admin без аутентификации
+	// позволил бы выпустить ключи, которые останутся и после её включения
+	anonymous := mw.Principal{Tenant: storage.DefaultTenant, Subject: "anonymous", Scopes: []string{mw.ScopeHashRead, mw.ScopeHashWrite}}
+	auth := mw.Auth(appCfg.AuthEnabled, anonymous, authenticators...)
+
+	var limits atomic.Pointer[mw.RateLimits]
+	limits.Store(rateLimits(appCfg, rdb, guarded.Healthy, logg))
+
+	// изменения config/service2/* применяются на ходу, где это безопасно;
+	// остальные ждут перезапуска. Изменения ищутся относительно последней
+	// загруженной конфигурации, а не действующей, иначе неприменённые поля
+	// считались бы изменёнными при каждой следующей правке
+	last := appCfg
+	go config.Watch(rootCtx, "consul:8500", func(next *config.AppConfig) {
+		cur := active.Load()
+		eff := *cur
+		changed := config.Changed(last, next)
+		last = next
+		for _, field := range changed {
+			switch field {
+			case "LogLevel":
+				level, _ := logrus.ParseLevel(next.LogLevel)
+				logg.SetLevel(level)
+				eff.LogLevel = next.LogLevel
+			case "CacheTTL":
+				h.SetCacheTTL(next.CacheTTL)
+				eff.CacheTTL = next.CacheTTL
+			case "MemoTTL":
+				if memo == nil {
+					logg.WithField("field", field).Warn("config: memo_key is not set, restart with it to enable memoization")
+					continue
+				}
+				memo.SetTTL(next.MemoTTL)
+				eff.MemoTTL = next.MemoTTL
+			case "RateLimitRPS", "RateLimitBurst", "DailyStringQuota":
+				eff.RateLimitRPS, eff.RateLimitBurst, eff.DailyStringQuota = next.RateLimitRPS, next.RateLimitBurst, next.DailyStringQuota
+			default:
+				logg.WithField("field", field).Warn("config: changed, restart to apply")
+				continue
+			}
+			logg.WithField("field", field).Info("config: applied")
+		}
+		if eff.RateLimitRPS != cur.RateLimitRPS || eff.RateLimitBurst != cur.RateLimitBurst || eff.DailyStringQuota != cur.DailyStringQuota {
+			limits.Store(rateLimits(&eff, rdb, guarded.Healthy, logg))
+		}
+		active.Store(&eff)
+	}, logg)
+
+	probes := health.New(time.Second, 2*time.Second, logg)
+	probes.Add("postgres", store.Pool.Ping)
+	probes.AddOptional("redis", redisCache.Ping)
+	probes.Add("service1", hashCl.Ping)
+
+	r := api.NewRouter(h, logg, auth, mw.RateLimitFunc(func() mw.RateLimits { return *limits.Load() }), probes, appCfg.AuthEnabled)
 
 	httpAddr := fmt.Sprintf(":%s", appCfg.HTTPPort)
 
@@ -87,9 +277,37 @@ func main() {
 
 	<-rootCtx.Done()
 	logg.Info("service2: shutting down...")
+	// сначала /readyz отвечает 503, и только потом сервер перестаёт
+	// принимать соединения
+	probes.Shutdown()
+	time.Sleep(appCfg.ShutdownDelay)
 
 	// даём 10 секунд на graceful
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	_ = srv.Shutdown(ctx)
 }
+
+// rateLimits builds the request rate limit and daily quota of cfg. Limits
+// are kept in Redis and counted in memory while it is down.
+func rateLimits(cfg *config.AppConfig, rdb *redis.Client, healthy func() bool, log *logrus.Logger) *mw.RateLimits {
+	var limits mw.RateLimits
+	if cfg.RateLimitRPS > 0 {
+		rate := mw.Rate{PerSecond: cfg.RateLimitRPS, Burst: cfg.RateLimitBurst}
+		limits.Requests = &mw.Fallback{
+			Primary:   mw.NewRedisTokenBucket(rdb, rate),
+			Secondary: mw.NewMemoryTokenBucket(rate),
+			Healthy:   healthy,
+			Log:       log,
+		}
+	}
+	if cfg.DailyStringQuota > 0 {
+		limits.DailyStrings = &mw.Fallback{
+			Primary:   mw.NewRedisDailyQuota(rdb, cfg.DailyStringQuota),
+			Secondary: mw.NewMemoryDailyQuota(cfg.DailyStringQuota),
+			Healthy:   healthy,
+			Log:       log,
+		}
+	}
+	return &limits
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"service2/internal/mw"
+	"service2/internal/storage"
+)
+
+// POST /admin/keys
+// body: {"tenant":"team-a","name":"etl","scopes":["hash:read","hash:write"]}
+// 201: {"id":1,"key":"hsk_...","tenant":"team-a","name":"etl","scopes":[...],"created_at":"..."}
+// Сам ключ возвращается только в этом ответе, в БД хранится его хэш.
+func (h *Handlers) CreateAPIKey(c *gin.Context) {
+	var in struct {
+		Tenant string   `json:"tenant"`
+		Name   string   `json:"name"`
+		Scopes []string `json:"scopes"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil || in.Tenant == "" || len(in.Scopes) == 0 {
+		problem(c, http.StatusBadRequest, CodeBadRequest, "tenant and scopes are required", nil)
+		return
+	}
+	for _, s := range in.Scopes {
+		if !slices.Contains(mw.KnownScopes, s) {
+			problem(c, http.StatusBadRequest, CodeBadRequest, fmt.Sprintf("unknown scope %q", s), nil)
+			return
+		}
+	}
+
+	reqID := mw.FromContext(c.Request.Context())
+	fail := func(err error) {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("admin: create api key failed")
+		problem(c, http.StatusInternalServerError, CodeInternal, "failed to create api key", nil)
+	}
+
+	key, err := mw.NewAPIKey()
+	if err != nil {
+		fail(err)
+		return
+	}
+	k, err := h.Store.CreateAPIKey(c.Request.Context(), in.Tenant, in.Name, mw.HashAPIKey(key), in.Scopes)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	h.Log.WithField("request_id", reqID).WithField("key_id", k.ID).WithField("tenant", k.Tenant).
+		Info("admin: api key created")
+	c.JSON(http.StatusCreated, gin.H{
+		"id":         k.ID,
+		"key":        key,
+		"tenant":     k.Tenant,
+		"name":       k.Name,
+		"scopes":     k.Scopes,
+		"created_at": k.CreatedAt,
+	})
+}
+
+// DELETE /admin/keys/:id
+// 204 — ключ отозван, 404 — активного ключа с таким id нет
+func (h *Handlers) RevokeAPIKey(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem(c, http.StatusBadRequest, CodeInvalidID, "key id must be an integer", nil)
+		return
+	}
+
+	reqID := mw.FromContext(c.Request.Context())
+	ok, err := h.Store.RevokeAPIKey(c.Request.Context(), id)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("admin: revoke api key failed")
+		problem(c, http.StatusInternalServerError, CodeInternal, "failed to revoke api key", nil)
+		return
+	}
+	if !ok {
+		problem(c, http.StatusNotFound, CodeNotFound, "no active key with this id", nil)
+		return
+	}
+
+	h.Log.WithField("request_id", reqID).WithField("key_id", id).Info("admin: api key revoked")
+	c.Status(http.StatusNoContent)
+}
+
+// LookupAPIKey resolves a hashed API key to its principal for mw.APIKeys.
+func (h *Handlers) LookupAPIKey(ctx context.Context, keyHash string) (*mw.Principal, error) {
+	k, err := h.Store.LookupAPIKey(ctx, keyHash)
+	if err != nil || k == nil {
+		return nil, err
+	}
+	return &mw.Principal{
+		Tenant:  k.Tenant,
+		Subject: fmt.Sprintf("apikey:%d", k.ID),
+		Scopes:  k.Scopes,
+	}, nil
+}
+
+// GET /admin/tenants/:tenant/quota
+// 200: {"tenant":"team-a","max_hashes":100000,"used":1234}, max_hashes 0 — без ограничения
+func (h *Handlers) GetQuota(c *gin.Context) {
+	q, err := h.Store.GetQuota(c.Request.Context(), c.Param("tenant"))
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", mw.FromContext(c.Request.Context())).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("admin: get quota failed")
+		problem(c, http.StatusInternalServerError, CodeInternal, "failed to read quota", nil)
+		return
+	}
+	c.JSON(http.StatusOK, quotaResponse(q))
+}
+
+// PUT /admin/tenants/:tenant/quota
+// body: {"max_hashes":100000}, 0 снимает ограничение
+// 200: как у GET. Уже сохранённые сверх новой квоты хеши не удаляются.
+func (h *Handlers) SetQuota(c *gin.Context) {
+	var in struct {
+		MaxHashes *int64 `json:"max_hashes"`
+	}
+	if err := c.ShouldBindJSON(&in); err != nil || in.MaxHashes == nil || *in.MaxHashes < 0 {
+		problem(c, http.StatusBadRequest, CodeBadRequest, "max_hashes must be a non-negative integer", nil)
+		return
+	}
+
+	reqID := mw.FromContext(c.Request.Context())
+	q, err := h.Store.SetQuota(c.Request.Context(), c.Param("tenant"), *in.MaxHashes)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("admin: set quota failed")
+		problem(c, http.StatusInternalServerError, CodeInternal, "failed to set quota", nil)
+		return
+	}
+
+	h.Log.WithField("request_id", reqID).WithField("tenant", q.Tenant).WithField("max_hashes", q.MaxHashes).
+		Info("admin: quota set")
+	c.JSON(http.StatusOK, quotaResponse(q))
+}
+
+func quotaResponse(q storage.Quota) gin.H {
+	return gin.H{"tenant": q.Tenant, "max_hashes": q.MaxHashes, "used": q.Used}
+}
+
+// GET /admin/config
+// 200: {"CacheTTL":"5m0s","DBDSN":"[redacted]",...} — действующая конфигурация,
+// секреты скрыты; 404, если она не передана
+func (h *Handlers) GetConfig(c *gin.Context) {
+	if h.Config == nil {
+		problem(c, http.StatusNotFound, CodeNotFound, "config is not exposed", nil)
+		return
+	}
+	c.JSON(http.StatusOK, h.Config())
+}
@@ -0,0 +1,107 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/api"
+	"service2/internal/cache"
+)
+
+type idStatus struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+	Hash   string `json:"hash,omitempty"`
+}
+
+func TestCheck(t *testing.T) {
+	h := newHandlers(checkStore(t))
+
+	// найденные ID в порядке запроса, без повторов, удалённых и неизвестных
+	want := []idHash{{3, "h:c"}, {1, "h:a"}}
+	w := call(h.Check, http.MethodGet, "/check?ids=3,2&ids=9&ids=1,3", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, want, decode[[]idHash](t, w))
+
+	w = call(h.Check, http.MethodPost, "/check", "application/json", `{"ids":[3,2,9,1,3]}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, want, decode[[]idHash](t, w))
+
+	w = call(h.Check, http.MethodGet, "/check?ids=2,9", "", "")
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Empty(t, w.Body.String())
+
+	cases := []struct{ name, method, target, body string }{
+		{"no ids", http.MethodGet, "/check", ""},
+		{"bad id", http.MethodGet, "/check?ids=1,x", ""},
+		{"bad mode", http.MethodGet, "/check?ids=1&mode=full", ""},
+		{"bad body", http.MethodPost, "/check", `{"ids":["x"]}`},
+		{"bad mode in body", http.MethodPost, "/check", `{"ids":[1],"mode":"full"}`},
+	}
+	for _, tc := range cases {
+		w := call(h.Check, tc.method, tc.target, "application/json", tc.body)
+		require.Equal(t, http.StatusBadRequest, w.Code, tc.name)
+	}
+}
+
+func TestCheck_StatusMode(t *testing.T) {
+	h := newHandlers(checkStore(t))
+
+	// каждый запрошенный ID со статусом, в порядке запроса и с повторами
+	want := []idStatus{
+		{ID: 3, Status: api.StatusFound, Hash: "h:c"},
+		{ID: 2, Status: api.StatusDeleted},
+		{ID: 9, Status: api.StatusNotFound},
+		{ID: 1, Status: api.StatusFound, Hash: "h:a"},
+		{ID: 3, Status: api.StatusFound, Hash: "h:c"},
+	}
+	w := call(h.Check, http.MethodGet, "/check?ids=3,2,9,1,3&mode=status", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, want, decode[[]idStatus](t, w))
+
+	w = call(h.Check, http.MethodPost, "/check", "application/json", `{"ids":[3,2,9,1,3],"mode":"status"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, want, decode[[]idStatus](t, w))
+
+	// без совпадений статусы всё равно возвращаются с 200
+	w = call(h.Check, http.MethodGet, "/check?ids=2,9&mode=status", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, want[1:3], decode[[]idStatus](t, w))
+}
+
+func TestCheck_CacheAndDB(t *testing.T) {
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	defer rdb.Close()
+	h := newHandlers(checkStore(t))
+	h.Cache = cache.NewRedis(rdb)
+	h.SetCacheTTL(time.Minute)
+	// значение в кэше отличается от базы, чтобы было видно, откуда оно
+	srv.Set(api.CachePrefix+"default:3", "cached:c")
+
+	// порядок ответа — порядок запроса, откуда бы ни пришёл хэш
+	want := []idStatus{
+		{ID: 3, Status: api.StatusFound, Hash: "cached:c"},
+		{ID: 9, Status: api.StatusNotFound},
+		{ID: 1, Status: api.StatusFound, Hash: "h:a"},
+		{ID: 2, Status: api.StatusDeleted},
+	}
+	w := call(h.Check, http.MethodGet, "/check?ids=3,9,1,2&mode=status", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, want, decode[[]idStatus](t, w))
+
+	w = call(h.Check, http.MethodGet, "/check?ids=1,3,1", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, []idHash{{1, "h:a"}, {3, "cached:c"}}, decode[[]idHash](t, w))
+
+	// найденное в базе попадает в кэш, удалённое — нет
+	v, err := srv.Get(api.CachePrefix + "default:1")
+	require.NoError(t, err)
+	require.Equal(t, "h:a", v)
+	require.False(t, srv.Exists(api.CachePrefix+"default:2"))
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"service2/internal/mw"
+	"service2/internal/storage"
+)
+
+const defaultContentType = "application/octet-stream"
+
+// POST /send/files
+// body: multipart/form-data, каждый файл хэшируется целиком по мере чтения;
+// тело не больше Limits.MaxUploadBytes (413), файлов не больше, чем service1
+// принимает за вызов (422)
+// 200: [{"id":38,"hash":"...","filename":"a.txt","size":12,"content_type":"text/plain"}]
+func (h *Handlers) SendFiles(c *gin.Context) {
+	h.limitUpload(c)
+	mr, err := c.Request.MultipartReader()
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("send files: bad request")
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	reqID := mw.FromContext(c.Request.Context())
+
+	// отмена закрывает стрим к service1, если загрузка оборвалась на середине
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	stream, err := h.HashClient.CalculateStream(ctx)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("send files: grpc stream failed")
+		c.Status(failStatus(c, err))
+		return
+	}
+
+	var files []storage.HashRow
+	buf := make([]byte, 32*1024)
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			werr := errors.WithStack(err)
+			h.Log.WithField("request_id", reqID).
+				WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("send files: bad multipart body")
+			c.Status(badBodyStatus(err))
+			return
+		}
+		// обычные поля формы не хэшируем
+		if part.FileName() == "" {
+			_ = part.Close()
+			continue
+		}
+		// все файлы идут в service1 одним стримом
+		if h.Limits.BatchItems > 0 && len(files) == h.Limits.BatchItems {
+			_ = part.Close()
+			h.Log.WithField("request_id", reqID).WithField("limit", h.Limits.BatchItems).
+				Warn("send files: too many files")
+			c.Status(http.StatusUnprocessableEntity)
+			return
+		}
+
+		size, readErr, writeErr := copyPart(stream, part, buf)
+		_ = part.Close()
+		if readErr != nil {
+			werr := errors.WithStack(readErr)
+			h.Log.WithField("request_id", reqID).
+				WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("send files: upload read failed")
+			c.Status(badBodyStatus(readErr))
+			return
+		}
+		if writeErr == nil {
+			writeErr = stream.EndItem()
+		}
+		if writeErr != nil {
+			werr := errors.WithStack(writeErr)
+			h.Log.WithField("request_id", reqID).
+				WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("send files: grpc send failed")
+			c.Status(failStatus(c, writeErr))
+			return
+		}
+
+		contentType := part.Header.Get("Content-Type")
+		if contentType == "" {
+			contentType = defaultContentType
+		}
+		files = append(files, storage.HashRow{
+			Filename:    part.FileName(),
+			Size:        size,
+			ContentType: contentType,
+		})
+	}
+	if len(files) == 0 {
+		c.JSON(http.StatusOK, []any{})
+		return
+	}
+
+	// число файлов известно только после чтения тела; квота возвращается,
+	// если файлы так и не сохранились
+	if err := mw.TakeDailyQuota(ctx, len(files)); err != nil {
+		h.Log.WithField("request_id", reqID).WithField("count", len(files)).WithError(err).
+			Warn("send files: daily quota exceeded")
+		c.Status(failStatus(c, err))
+		return
+	}
+	stored := false
+	defer func() {
+		if !stored {
+			mw.RefundDailyQuota(ctx, len(files))
+		}
+	}()
+
+	h.Log.WithField("request_id", reqID).WithField("count", len(files)).Info("send files: hashing")
+
+	hashes, err := stream.Finish()
+	if err == nil && len(hashes) != len(files) {
+		err = fmt.Errorf("got %d hashes for %d files", len(hashes), len(files))
+	}
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("send files: grpc call failed")
+		c.Status(failStatus(c, err))
+		return
+	}
+	for i := range files {
+		files[i].Hash = hashes[i]
+	}
+
+	rows, err := h.Store.InsertRows(ctx, tenantOf(ctx), files)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("send files: db insert failed")
+		c.Status(failStatus(c, err))
+		return
+	}
+	stored = true
+
+	h.cacheRows(ctx, rows)
+
+	type resp struct {
+		ID          int64  `json:"id"`
+		Hash        string `json:"hash"`
+		Filename    string `json:"filename"`
+		Size        int64  `json:"size"`
+		ContentType string `json:"content_type"`
+	}
+	out := make([]resp, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, resp{ID: r.ID, Hash: r.Hash, Filename: r.Filename, Size: r.Size, ContentType: r.ContentType})
+	}
+
+	h.Log.WithField("request_id", reqID).WithField("saved", len(out)).Info("send files: done")
+	c.JSON(http.StatusOK, out)
+}
+
+// copyPart streams one multipart part into the hash stream. Read errors mean a
+// broken upload and are reported separately from errors talking to service1.
+func copyPart(dst io.Writer, src io.Reader, buf []byte) (n int64, readErr, writeErr error) {
+	for {
+		nr, err := src.Read(buf)
+		if nr > 0 {
+			if _, werr := dst.Write(buf[:nr]); werr != nil {
+				return n, nil, werr
+			}
+			n += int64(nr)
+		}
+		if err == io.EOF {
+			return n, nil, nil
+		}
+		if err != nil {
+			return n, err, nil
+		}
+	}
+}
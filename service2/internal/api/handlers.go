@@ -1,40 +1,81 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"github.com/pkg/errors"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 
+	"service2/internal/cache"
 	"service2/internal/grpcclient"
 	"service2/internal/mw"
 	"service2/internal/storage"
 )
 
+// Storage is the part of *storage.Store the handlers use.
+type Storage interface {
+	InsertHashes(ctx context.Context, tenant string, hashes []string) ([]storage.HashRow, error)
+	InsertRows(ctx context.Context, tenant string, in []storage.HashRow) ([]storage.HashRow, error)
+	GetByIDs(ctx context.Context, tenant string, ids []int64) ([]storage.HashRow, error)
+	Delete(ctx context.Context, tenant string, ids []int64) ([]int64, error)
+	List(ctx context.Context, f storage.ListFilter, limit int) ([]storage.HashRow, error)
+	Export(ctx context.Context, f storage.ListFilter, fn func(storage.HashRow) error) error
+
+	LookupAPIKey(ctx context.Context, keyHash string) (*storage.APIKey, error)
+	CreateAPIKey(ctx context.Context, tenant, name, keyHash string, scopes []string) (storage.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int64) (bool, error)
+	GetQuota(ctx context.Context, tenant string) (storage.Quota, error)
+	SetQuota(ctx context.Context, tenant string, maxHashes int64) (storage.Quota, error)
+}
+
 type Handlers struct {
 	HashClient grpcclient.HasherClient
-	Store      *storage.Store
+	Store      Storage
 	Log        *logrus.Logger
-	Cache      *redis.Client
-	CacheTTL   time.Duration
+	Cache      cache.Cache
+	Limits     Limits
+	// Config, если задан, возвращает действующую конфигурацию для
+	// /admin/config без секретов
+	Config func() map[string]any
+
+	cacheTTL atomic.Int64
+}
+
+// SetCacheTTL sets how long hashes are kept in the cache. It may be called
+// while serving.
+func (h *Handlers) SetCacheTTL(ttl time.Duration) {
+	h.cacheTTL.Store(int64(ttl))
 }
 
 // POST /send
 // body: ["str1","str2",...]
 // 200: [{"id":38,"hash":"..."}]
+// С Content-Type: application/x-ndjson работает потоково, см. sendNDJSON.
 func (h *Handlers) Send(c *gin.Context) {
+	if c.ContentType() == mimeNDJSON {
+		h.sendNDJSON(c)
+		return
+	}
+
+	h.limitBody(c)
 	var in []string
 	if err := c.ShouldBindJSON(&in); err != nil {
 		werr := errors.WithStack(err)
 		h.Log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
 			Error("send: bad request")
-		c.Status(http.StatusBadRequest)
+		c.Status(badBodyStatus(err))
+		return
+	}
+	if h.Limits.tooManyItems(len(in)) || h.Limits.anyTooLong(in) {
+		c.Status(http.StatusUnprocessableEntity)
 		return
 	}
 	if len(in) == 0 {
@@ -45,23 +86,13 @@ func (h *Handlers) Send(c *gin.Context) {
 	reqID := mw.FromContext(c.Request.Context())
 	h.Log.WithField("request_id", reqID).WithField("count", len(in)).Info("send: hashing")
 
-	hashes, err := h.HashClient.Calculate(c.Request.Context(), in)
+	rows, err := h.hashAndStore(c.Request.Context(), in, nil)
 	if err != nil {
 		werr := errors.WithStack(err)
-		h.Log.WithField("request_id", mw.FromContext(c.Request.Context())).
+		h.Log.WithField("request_id", reqID).
 			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
-			Error("send: grpc call failed")
-		c.Status(http.StatusInternalServerError)
-		return
-	}
-
-	rows, err := h.Store.InsertHashes(c.Request.Context(), hashes)
-	if err != nil {
-		werr := errors.WithStack(err)
-		h.Log.WithField("request_id", mw.FromContext(c.Request.Context())).
-			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
-			Error("send: db insert failed")
-		c.Status(http.StatusInternalServerError)
+			Error("send: " + err.Error())
+		c.Status(failStatus(c, err))
 		return
 	}
 
@@ -69,14 +100,6 @@ func (h *Handlers) Send(c *gin.Context) {
 		ID   int64  `json:"id"`
 		Hash string `json:"hash"`
 	}
-	if h.Cache != nil {
-		for _, r := range rows {
-			if err := h.Cache.Set(c.Request.Context(), fmt.Sprintf("hash:%d", r.ID), r.Hash, h.CacheTTL).Err(); err != nil {
-				h.Log.WithField("request_id", reqID).WithError(err).Error("send: cache set failed")
-			}
-		}
-	}
-
 	out := make([]resp, 0, len(rows))
 	for _, r := range rows {
 		out = append(out, resp{ID: r.ID, Hash: r.Hash})
@@ -86,54 +109,255 @@ func (h *Handlers) Send(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+// hashAndStore hashes in through service1, saves the hashes and puts them
+// into the cache. meta, if not nil, holds metadata for every input and is
+// saved along with the hashes. The error message names the step that failed.
+// The daily quota is spent up front and given back if nothing was saved.
+func (h *Handlers) hashAndStore(ctx context.Context, in []string, meta []storage.HashRow) (_ []storage.HashRow, err error) {
+	if err := mw.TakeDailyQuota(ctx, len(in)); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			mw.RefundDailyQuota(ctx, len(in))
+		}
+	}()
+
+	hashes := make([]string, 0, len(in))
+	for _, batch := range h.Limits.grpcBatches(in) {
+		out, err := h.HashClient.Calculate(ctx, batch)
+		if err != nil {
+			return nil, errors.Wrap(err, "grpc call failed")
+		}
+		hashes = append(hashes, out...)
+	}
+
+	var rows []storage.HashRow
+	if meta == nil {
+		rows, err = h.Store.InsertHashes(ctx, tenantOf(ctx), hashes)
+	} else {
+		for i := range meta {
+			meta[i].Hash = hashes[i]
+		}
+		rows, err = h.Store.InsertRows(ctx, tenantOf(ctx), meta)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "db insert failed")
+	}
+
+	h.cacheRows(ctx, rows)
+	return rows, nil
+}
+
+// failStatus maps a failed hash-and-store to the response status: a tenant
+// over its storage quota gets 403, a client over its daily quota 429 with
+// Retry-After, service1 being down or overloaded 503 with Retry-After,
+// anything else is a server error.
+func failStatus(c *gin.Context, err error) int {
+	var qe *mw.QuotaError
+	switch {
+	case errors.As(err, &qe):
+		setRetryAfter(c, qe.RetryAfter)
+		return http.StatusTooManyRequests
+	case errors.Is(err, storage.ErrQuotaExceeded):
+		return http.StatusForbidden
+	}
+	if d, ok := grpcclient.RetryAfter(err); ok {
+		setRetryAfter(c, d)
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+func setRetryAfter(c *gin.Context, d time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+}
+
+// cacheRows puts newly stored rows into the cache. Failures are only logged:
+// the rows are already saved and will be read from the DB on a miss.
+func (h *Handlers) cacheRows(ctx context.Context, rows []storage.HashRow) {
+	if h.Cache == nil || len(rows) == 0 {
+		return
+	}
+	if err := h.Cache.SetMany(ctx, h.cacheEntries(ctx, rows), time.Duration(h.cacheTTL.Load())); err != nil {
+		h.logCacheError(ctx, "cache set failed", err)
+	}
+}
+
+// fillCache puts rows read from the DB into the cache unless they were
+// deleted meanwhile: a concurrent delete leaves a tombstone that AddMany
+// respects, so a stale read never brings a deleted row back.
+func (h *Handlers) fillCache(ctx context.Context, rows []storage.HashRow) {
+	if h.Cache == nil || len(rows) == 0 {
+		return
+	}
+	if err := h.Cache.AddMany(ctx, h.cacheEntries(ctx, rows), time.Duration(h.cacheTTL.Load())); err != nil {
+		h.logCacheError(ctx, "cache set failed", err)
+	}
+}
+
+func (h *Handlers) cacheEntries(ctx context.Context, rows []storage.HashRow) []cache.Entry {
+	tenant := tenantOf(ctx)
+	entries := make([]cache.Entry, len(rows))
+	for i, r := range rows {
+		entries[i] = cache.Entry{Key: cacheKey(tenant, r.ID), Value: r.Hash}
+	}
+	return entries
+}
+
+// Статусы элементов ответа /check в режиме mode=status.
+const (
+	StatusFound    = "found"
+	StatusNotFound = "not_found"
+	StatusDeleted  = "deleted"
+)
+
+const checkModeStatus = "status"
+
+// checkRequest — разобранный запрос /check, одинаковый для GET и POST.
+type checkRequest struct {
+	IDs  []int64 `json:"ids"`
+	Mode string  `json:"mode"`
+}
+
 // GET /check?ids=1&ids=2 или /check?ids=1,2
-// 200: [{"id":38,"hash":"..."}], 204 если нет совпадений
+// POST /check, body: {"ids":[1,2],"mode":"status"} — для больших наборов ID
+// 200: [{"id":38,"hash":"..."}] в порядке запроса, 204 если нет совпадений
+// С mode=status возвращается каждый запрошенный ID со статусом, всегда 200:
+// [{"id":38,"status":"found","hash":"..."},{"id":39,"status":"not_found"}]
 func (h *Handlers) Check(c *gin.Context) {
-	idsParam := c.QueryArray("ids")
-	if len(idsParam) == 0 {
-		if raw := c.Query("ids"); raw != "" {
-			idsParam = strings.Split(raw, ",")
+	var req checkRequest
+	if c.Request.Method == http.MethodPost {
+		h.limitBody(c)
+		if err := c.ShouldBindJSON(&req); err != nil {
+			werr := errors.WithStack(err)
+			h.Log.WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("check: bad request")
+			c.Status(badBodyStatus(err))
+			return
+		}
+	} else {
+		req.Mode = c.Query("mode")
+		for _, s := range queryIDs(c) {
+			if s == "" {
+				continue
+			}
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				c.Status(http.StatusBadRequest)
+				return
+			}
+			req.IDs = append(req.IDs, v)
 		}
 	}
-	if len(idsParam) == 0 {
+	if len(req.IDs) == 0 || (req.Mode != "" && req.Mode != checkModeStatus) {
 		c.Status(http.StatusBadRequest)
 		return
 	}
+	if h.Limits.tooManyItems(len(req.IDs)) {
+		c.Status(http.StatusUnprocessableEntity)
+		return
+	}
+
+	reqID := mw.FromContext(c.Request.Context())
+	h.Log.WithField("request_id", reqID).WithField("count", len(req.IDs)).Info("check: start")
 
-	var ids []int64
-	for _, s := range idsParam {
-		if s == "" {
+	found, err := h.lookup(c.Request.Context(), req.IDs)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("check: db failed")
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	if req.Mode == checkModeStatus {
+		out := checkStatuses(req.IDs, found)
+		h.Log.WithField("request_id", reqID).WithField("found", len(found)).Info("check: done")
+		c.JSON(http.StatusOK, out)
+		return
+	}
+
+	type resp struct {
+		ID   int64  `json:"id"`
+		Hash string `json:"hash"`
+	}
+	out := make([]resp, 0, len(found))
+	seen := make(map[int64]bool, len(found))
+	for _, id := range req.IDs {
+		r, ok := found[id]
+		if !ok || r.Deleted || seen[id] {
 			continue
 		}
-		v, err := strconv.ParseInt(s, 10, 64)
-		if err != nil {
-			c.Status(http.StatusBadRequest)
-			return
+		seen[id] = true
+		out = append(out, resp{ID: id, Hash: r.Hash})
+	}
+	if len(out) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+	h.Log.WithField("request_id", reqID).WithField("found", len(out)).Info("check: done")
+	c.JSON(http.StatusOK, out)
+}
+
+type idStatus struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+	Hash   string `json:"hash,omitempty"`
+}
+
+// checkStatuses returns an entry for every id, in request order.
+func checkStatuses(ids []int64, found map[int64]storage.HashRow) []idStatus {
+	out := make([]idStatus, 0, len(ids))
+	for _, id := range ids {
+		r, ok := found[id]
+		switch {
+		case !ok:
+			out = append(out, idStatus{ID: id, Status: StatusNotFound})
+		case r.Deleted:
+			out = append(out, idStatus{ID: id, Status: StatusDeleted})
+		default:
+			out = append(out, idStatus{ID: id, Status: StatusFound, Hash: r.Hash})
 		}
-		ids = append(ids, v)
 	}
+	return out
+}
 
-	reqID := mw.FromContext(c.Request.Context())
-	h.Log.WithField("request_id", reqID).WithField("ids", ids).Info("check: start")
+// queryIDs reads the ids query parameter given either repeated or comma
+// separated.
+func queryIDs(c *gin.Context) []string {
+	var out []string
+	for _, v := range c.QueryArray("ids") {
+		out = append(out, strings.Split(v, ",")...)
+	}
+	return out
+}
 
-	ctx := c.Request.Context()
-	var rows []storage.HashRow
+// lookup returns stored rows for ids keyed by id, reading the cache first and
+// going to the DB only for misses. Soft-deleted rows are returned with Deleted
+// set; IDs that were never stored are absent from the result.
+func (h *Handlers) lookup(ctx context.Context, ids []int64) (map[int64]storage.HashRow, error) {
+	found := make(map[int64]storage.HashRow, len(ids))
+	if len(ids) == 0 {
+		return found, nil
+	}
+
+	tenant := tenantOf(ctx)
 	miss := ids
 	if h.Cache != nil {
 		keys := make([]string, len(ids))
 		for i, id := range ids {
-			keys[i] = fmt.Sprintf("hash:%d", id)
+			keys[i] = cacheKey(tenant, id)
 		}
-		vals, err := h.Cache.MGet(ctx, keys...).Result()
+		vals, err := h.Cache.Get(ctx, keys)
 		if err != nil {
-			h.Log.WithField("request_id", reqID).WithError(err).Error("check: cache get failed")
+			h.logCacheError(ctx, "cache get failed", err)
 		} else {
 			miss = make([]int64, 0)
 			for i, v := range vals {
-				if v != nil {
-					if s, ok := v.(string); ok {
-						rows = append(rows, storage.HashRow{ID: ids[i], Hash: s})
-					}
+				if v != "" {
+					found[ids[i]] = storage.HashRow{ID: ids[i], Hash: v}
 				} else {
 					miss = append(miss, ids[i])
 				}
@@ -142,37 +366,59 @@ func (h *Handlers) Check(c *gin.Context) {
 	}
 
 	if len(miss) > 0 {
-		dbRows, err := h.Store.GetByIDs(ctx, miss)
+		dbRows, err := h.Store.GetByIDs(ctx, tenant, miss)
 		if err != nil {
-			werr := errors.WithStack(err)
-			h.Log.WithField("request_id", mw.FromContext(c.Request.Context())).
-				WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
-				Error("check: db failed")
-			c.Status(http.StatusInternalServerError)
-			return
+			return nil, err
 		}
-		rows = append(rows, dbRows...)
-		if h.Cache != nil {
-			for _, r := range dbRows {
-				if err := h.Cache.Set(ctx, fmt.Sprintf("hash:%d", r.ID), r.Hash, h.CacheTTL).Err(); err != nil {
-					h.Log.WithField("request_id", reqID).WithError(err).Error("check: cache set failed")
-				}
+		live := make([]storage.HashRow, 0, len(dbRows))
+		for _, r := range dbRows {
+			found[r.ID] = r
+			if !r.Deleted {
+				live = append(live, r)
 			}
 		}
+		h.fillCache(ctx, live)
 	}
-	if len(rows) == 0 {
-		c.Status(http.StatusNoContent)
+	return found, nil
+}
+
+// uncache removes ids from the cache, so that deleted rows stop being served.
+func (h *Handlers) uncache(ctx context.Context, ids []int64) {
+	if h.Cache == nil || len(ids) == 0 {
 		return
 	}
+	tenant := tenantOf(ctx)
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = cacheKey(tenant, id)
+	}
+	if err := h.Cache.Del(ctx, keys...); err != nil {
+		h.logCacheError(ctx, "cache del failed", err)
+	}
+}
 
-	type resp struct {
-		ID   int64  `json:"id"`
-		Hash string `json:"hash"`
+// logCacheError logs a failed cache call. Calls skipped while the cache is
+// down are not logged: the outage is logged once when it starts.
+func (h *Handlers) logCacheError(ctx context.Context, msg string, err error) {
+	if errors.Is(err, cache.ErrUnavailable) {
+		return
 	}
-	out := make([]resp, 0, len(rows))
-	for _, r := range rows {
-		out = append(out, resp{ID: r.ID, Hash: r.Hash})
+	h.Log.WithField("request_id", mw.FromContext(ctx)).WithError(err).Error(msg)
+}
+
+// CachePrefix starts the cache key of every stored hash.
+const CachePrefix = "hash:"
+
+// cacheKey namespaces cached hashes by tenant, so that IDs of one tenant are
+// never served to another from the cache.
+func cacheKey(tenant string, id int64) string {
+	return fmt.Sprintf("%s%s:%d", CachePrefix, tenant, id)
+}
+
+// tenantOf returns the tenant of the caller of the request.
+func tenantOf(ctx context.Context) string {
+	if p := mw.PrincipalFromContext(ctx); p != nil && p.Tenant != "" {
+		return p.Tenant
 	}
-	h.Log.WithField("request_id", reqID).WithField("found", len(out)).Info("check: done")
-	c.JSON(http.StatusOK, out)
+	return storage.DefaultTenant
 }
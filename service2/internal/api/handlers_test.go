@@ -0,0 +1,299 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/api"
+	"service2/internal/grpcclient"
+	"service2/internal/mw"
+	"service2/internal/storage"
+)
+
+// fakeHasher "hashes" a string or a streamed item into "h:" + its content.
+type fakeHasher struct {
+	grpcclient.HasherClient
+	err error
+}
+
+func (f *fakeHasher) Calculate(_ context.Context, in []string) ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := make([]string, len(in))
+	for i, s := range in {
+		out[i] = "h:" + s
+	}
+	return out, nil
+}
+
+func (f *fakeHasher) CalculateStream(context.Context) (grpcclient.HashStream, error) {
+	return &fakeStream{err: f.err}, nil
+}
+
+type fakeStream struct {
+	err   error
+	item  bytes.Buffer
+	items []string
+}
+
+func (s *fakeStream) Write(p []byte) (int, error) { return s.item.Write(p) }
+
+func (s *fakeStream) EndItem() error {
+	s.items = append(s.items, "h:"+s.item.String())
+	s.item.Reset()
+	return nil
+}
+
+func (s *fakeStream) Finish() ([]string, error) { return s.items, s.err }
+
+var errFakeDB = errors.New("fake db failed")
+
+// memStore keeps rows in memory the way the database would: IDs grow, rows
+// belong to a tenant and deleted rows are kept with Deleted set.
+type memStore struct {
+	api.Storage
+
+	mu   sync.Mutex
+	rows []memRow
+	// failInsert — номер вызова вставки (с 1), который падает с insertErr
+	// или errFakeDB
+	failInsert int
+	insertErr  error
+	inserts    int
+	// failExport — после скольких строк экспорт падает с errFakeDB, 0 — не падает
+	failExport int
+}
+
+type memRow struct {
+	tenant string
+	storage.HashRow
+}
+
+func (m *memStore) InsertHashes(ctx context.Context, tenant string, hashes []string) ([]storage.HashRow, error) {
+	in := make([]storage.HashRow, len(hashes))
+	for i, h := range hashes {
+		in[i].Hash = h
+	}
+	return m.InsertRows(ctx, tenant, in)
+}
+
+func (m *memStore) InsertRows(_ context.Context, tenant string, in []storage.HashRow) ([]storage.HashRow, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inserts++
+	if m.inserts == m.failInsert {
+		if m.insertErr != nil {
+			return nil, m.insertErr
+		}
+		return nil, errFakeDB
+	}
+	out := make([]storage.HashRow, len(in))
+	for i, r := range in {
+		r.ID = int64(len(m.rows) + 1)
+		r.CreatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		m.rows = append(m.rows, memRow{tenant: tenant, HashRow: r})
+		out[i] = r
+	}
+	return out, nil
+}
+
+func (m *memStore) GetByIDs(_ context.Context, tenant string, ids []int64) ([]storage.HashRow, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []storage.HashRow
+	for _, r := range m.rows {
+		for _, id := range ids {
+			if r.ID == id && r.tenant == tenant {
+				out = append(out, r.HashRow)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) Delete(_ context.Context, tenant string, ids []int64) ([]int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []int64
+	for i := range m.rows {
+		r := &m.rows[i]
+		for _, id := range ids {
+			if r.ID == id && r.tenant == tenant && !r.Deleted {
+				r.Deleted = true
+				out = append(out, id)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) match(f storage.ListFilter) []storage.HashRow {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []storage.HashRow
+	for _, r := range m.rows {
+		if r.tenant != f.Tenant || r.Deleted || r.ID <= f.AfterID || f.ToID > 0 && r.ID > f.ToID ||
+			f.Source != "" && r.Source != f.Source || f.Label != "" && r.Label != f.Label {
+			continue
+		}
+		out = append(out, r.HashRow)
+	}
+	return out
+}
+
+func (m *memStore) List(_ context.Context, f storage.ListFilter, limit int) ([]storage.HashRow, error) {
+	out := m.match(f)
+	return out[:min(limit, len(out))], nil
+}
+
+func (m *memStore) Export(_ context.Context, f storage.ListFilter, fn func(storage.HashRow) error) error {
+	for i, r := range m.match(f) {
+		if m.failExport > 0 && i == m.failExport {
+			return errFakeDB
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// testRequestID is the request ID of requests made by call.
+const testRequestID = "test-request"
+
+// call runs handler on a bare gin test context, without the router and its
+// middleware.
+func call(handler func(*gin.Context), method, target, contentType, body string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	ctx, _ := mw.EnsureRequestID(context.Background(), testRequestID)
+	c.Request = httptest.NewRequestWithContext(ctx, method, target, strings.NewReader(body))
+	if contentType != "" {
+		c.Request.Header.Set("Content-Type", contentType)
+	}
+	handler(c)
+	c.Writer.WriteHeaderNow()
+	return w
+}
+
+func newHandlers(store *memStore) *api.Handlers {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	return &api.Handlers{HashClient: &fakeHasher{}, Store: store, Log: log}
+}
+
+func decode[T any](t *testing.T, w *httptest.ResponseRecorder) T {
+	t.Helper()
+	var v T
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &v), w.Body.String())
+	return v
+}
+
+type fileRow struct {
+	ID          int64  `json:"id"`
+	Hash        string `json:"hash"`
+	Filename    string `json:"filename"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+}
+
+type formPart struct {
+	field, filename, contentType, content string
+}
+
+func multipartBody(t *testing.T, parts ...formPart) (string, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	mpw := multipart.NewWriter(&buf)
+	for _, p := range parts {
+		hdr := textproto.MIMEHeader{}
+		disp := fmt.Sprintf(`form-data; name=%q`, p.field)
+		if p.filename != "" {
+			disp += fmt.Sprintf(`; filename=%q`, p.filename)
+		}
+		hdr.Set("Content-Disposition", disp)
+		if p.contentType != "" {
+			hdr.Set("Content-Type", p.contentType)
+		}
+		pw, err := mpw.CreatePart(hdr)
+		require.NoError(t, err)
+		_, err = io.WriteString(pw, p.content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, mpw.Close())
+	return mpw.FormDataContentType(), buf.String()
+}
+
+func TestSendFiles(t *testing.T) {
+	store := &memStore{}
+	h := newHandlers(store)
+
+	ct, body := multipartBody(t,
+		formPart{field: "file", filename: "b.txt", contentType: "text/plain", content: "hello"},
+		formPart{field: "comment", content: "not a file"},
+		formPart{field: "file", filename: "a.bin", content: "\x00\x01"},
+		formPart{field: "file", filename: "empty.txt", contentType: "text/plain"},
+	)
+	w := call(h.SendFiles, http.MethodPost, "/send/files", ct, body)
+	require.Equal(t, http.StatusOK, w.Code)
+	// файлы в порядке загрузки, поля формы пропускаются, содержимое хэшируется
+	// как есть
+	want := []fileRow{
+		{ID: 1, Hash: "h:hello", Filename: "b.txt", Size: 5, ContentType: "text/plain"},
+		{ID: 2, Hash: "h:\x00\x01", Filename: "a.bin", Size: 2, ContentType: "application/octet-stream"},
+		{ID: 3, Hash: "h:", Filename: "empty.txt", Size: 0, ContentType: "text/plain"},
+	}
+	require.Equal(t, want, decode[[]fileRow](t, w))
+	require.Len(t, store.rows, 3)
+	require.Equal(t, "a.bin", store.rows[1].Filename)
+	require.Equal(t, int64(2), store.rows[1].Size)
+	require.Equal(t, "application/octet-stream", store.rows[1].ContentType)
+
+	ct, body = multipartBody(t, formPart{field: "comment", content: "no files"})
+	w = call(h.SendFiles, http.MethodPost, "/send/files", ct, body)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[]`, w.Body.String())
+}
+
+func TestSendFiles_Errors(t *testing.T) {
+	store := &memStore{}
+	h := newHandlers(store)
+	ct, body := multipartBody(t, formPart{field: "file", filename: "a.txt", content: "a"})
+
+	w := call(h.SendFiles, http.MethodPost, "/send/files", "application/json", `["a"]`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// тело оборвано посреди файла
+	w = call(h.SendFiles, http.MethodPost, "/send/files", ct, body[:len(body)-10])
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	h.HashClient = &fakeHasher{err: errors.New("service1 down")}
+	w = call(h.SendFiles, http.MethodPost, "/send/files", ct, body)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+
+	h.HashClient = &fakeHasher{}
+	store.failInsert = 1
+	w = call(h.SendFiles, http.MethodPost, "/send/files", ct, body)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Empty(t, store.rows)
+}
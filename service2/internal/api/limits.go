@@ -0,0 +1,112 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Limits bound the size of a request. Zero fields are not enforced.
+type Limits struct {
+	// MaxBodyBytes limits JSON bodies.
+	MaxBodyBytes int64
+	// MaxUploadBytes limits the whole body of a file upload or CSV import.
+	// NDJSON feeds are not limited in total, only per item.
+	MaxUploadBytes int64
+	// MaxItems limits the number of strings or IDs in one JSON request.
+	MaxItems int
+	// MaxItemBytes limits the length of one string in bytes.
+	MaxItemBytes int
+	// BatchItems is the most strings service1 takes in one call, its own
+	// max_items; longer inputs are split into several calls.
+	BatchItems int
+}
+
+// Коды ошибок /v2 при нарушении лимитов.
+const (
+	CodePayloadTooLarge = "payload_too_large"
+	CodeTooManyItems    = "too_many_items"
+	CodeItemTooLong     = "item_too_long"
+)
+
+const (
+	// grpcBatchBytes — сколько байт уходит в service1 одним вызовом, с
+	// запасом до лимита сообщения gRPC в 4 МиБ
+	grpcBatchBytes = 3 << 20
+	// grpcItemOverhead — байты protobuf на каждую строку: тег и длина
+	grpcItemOverhead = 6
+)
+
+var errItemTooLong = errors.New("item too long")
+
+// limitBody makes reads of the request body beyond MaxBodyBytes fail, see
+// isTooLarge.
+func (h *Handlers) limitBody(c *gin.Context) {
+	if h.Limits.MaxBodyBytes > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.Limits.MaxBodyBytes)
+	}
+}
+
+// limitUpload is limitBody for file uploads and CSV imports, with
+// MaxUploadBytes.
+func (h *Handlers) limitUpload(c *gin.Context) {
+	if h.Limits.MaxUploadBytes > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.Limits.MaxUploadBytes)
+	}
+}
+
+// isTooLarge reports whether err comes from a body cut off by limitBody or
+// limitUpload.
+func isTooLarge(err error) bool {
+	var mbe *http.MaxBytesError
+	return errors.As(err, &mbe)
+}
+
+// badBodyStatus is the status for a body that failed to decode.
+func badBodyStatus(err error) int {
+	if isTooLarge(err) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+func (l Limits) tooManyItems(n int) bool {
+	return l.MaxItems > 0 && n > l.MaxItems
+}
+
+func (l Limits) tooLong(s string) bool {
+	return l.MaxItemBytes > 0 && len(s) > l.MaxItemBytes
+}
+
+// anyTooLong reports whether a string of in is over MaxItemBytes.
+func (l Limits) anyTooLong(in []string) bool {
+	for _, s := range in {
+		if l.tooLong(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l Limits) itemTooLongDetail() string {
+	return fmt.Sprintf("item is longer than %d bytes", l.MaxItemBytes)
+}
+
+// grpcBatches splits in into runs small enough for one call to service1:
+// at most BatchItems strings and grpcBatchBytes bytes of message.
+func (l Limits) grpcBatches(in []string) [][]string {
+	var out [][]string
+	start, size := 0, 0
+	for i, s := range in {
+		full := size+len(s)+grpcItemOverhead > grpcBatchBytes ||
+			l.BatchItems > 0 && i-start == l.BatchItems
+		if full && i > start {
+			out = append(out, in[start:i])
+			start, size = i, 0
+		}
+		size += len(s) + grpcItemOverhead
+	}
+	return append(out, in[start:])
+}
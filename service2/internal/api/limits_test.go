@@ -0,0 +1,185 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/api"
+	"service2/internal/grpcclient"
+	"service2/internal/mw"
+)
+
+// newLimitedRouter serves the API without service1, a database or a cache:
+// requests over the limits are rejected before any of them is needed.
+func newLimitedRouter(l api.Limits) *gin.Engine {
+	return newRouter(&api.Handlers{Limits: l})
+}
+
+// newRouter serves h to an anonymous caller allowed to read and write, with
+// the log of h discarded.
+func newRouter(h *api.Handlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	h.Log = logrus.New()
+	h.Log.SetOutput(io.Discard)
+	anonymous := mw.Principal{Tenant: "default", Subject: "anonymous", Scopes: []string{mw.ScopeHashRead, mw.ScopeHashWrite}}
+	return api.NewRouter(h, h.Log, mw.Auth(false, anonymous), nil, nil, false)
+}
+
+func serve(r http.Handler, method, target, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestLimits_V1(t *testing.T) {
+	r := newLimitedRouter(api.Limits{MaxBodyBytes: 64, MaxItems: 2, MaxItemBytes: 4})
+
+	cases := []struct {
+		name, method, target, contentType, body string
+		want                                    int
+	}{
+		{"body too large", http.MethodPost, "/send", "application/json", `["` + strings.Repeat("a", 100) + `"]`, http.StatusRequestEntityTooLarge},
+		{"too many strings", http.MethodPost, "/send", "application/json", `["a","b","c"]`, http.StatusUnprocessableEntity},
+		{"string too long", http.MethodPost, "/v1/send", "application/json", `["abcde"]`, http.StatusUnprocessableEntity},
+		{"check body too large", http.MethodPost, "/check", "application/json", `{"ids":[` + strings.Repeat("1,", 40) + `1]}`, http.StatusRequestEntityTooLarge},
+		{"too many ids", http.MethodPost, "/check", "application/json", `{"ids":[1,2,3]}`, http.StatusUnprocessableEntity},
+		{"too many ids in query", http.MethodGet, "/check?ids=1,2,3", "", "", http.StatusUnprocessableEntity},
+	}
+	for _, tc := range cases {
+		w := serve(r, tc.method, tc.target, tc.contentType, tc.body)
+		require.Equal(t, tc.want, w.Code, tc.name)
+	}
+
+	// импорт сообщает, сколько строк уже сохранено
+	w := serve(r, http.MethodPost, "/import", "text/csv", "value\nabcde\n")
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	var body struct {
+		Imported int `json:"imported"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Zero(t, body.Imported)
+}
+
+func TestLimits_V2(t *testing.T) {
+	r := newLimitedRouter(api.Limits{MaxBodyBytes: 64, MaxItems: 2, MaxItemBytes: 4})
+
+	cases := []struct {
+		name, method, target, body string
+		status                     int
+		code                       string
+	}{
+		{"body too large", http.MethodPost, "/v2/send", `["` + strings.Repeat("a", 100) + `"]`, http.StatusRequestEntityTooLarge, api.CodePayloadTooLarge},
+		{"too many strings", http.MethodPost, "/v2/send", `["a","b","c"]`, http.StatusUnprocessableEntity, api.CodeTooManyItems},
+		{"all strings too long", http.MethodPost, "/v2/send", `["abcde","fghij"]`, http.StatusUnprocessableEntity, api.CodeInvalidItem},
+		{"check body too large", http.MethodPost, "/v2/check", `{"ids":[` + strings.Repeat("1,", 40) + `1]}`, http.StatusRequestEntityTooLarge, api.CodePayloadTooLarge},
+		{"too many ids", http.MethodPost, "/v2/check", `{"ids":[1,2,3]}`, http.StatusUnprocessableEntity, api.CodeTooManyItems},
+		{"too many ids with invalid ones", http.MethodGet, "/v2/check?ids=1,x,3", "", http.StatusUnprocessableEntity, api.CodeTooManyItems},
+	}
+	for _, tc := range cases {
+		w := serve(r, tc.method, tc.target, "application/json", tc.body)
+		require.Equal(t, tc.status, w.Code, tc.name)
+		require.Equal(t, mw.MimeProblem, w.Header().Get("Content-Type"), tc.name)
+
+		var p mw.Problem
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &p), tc.name)
+		require.Equal(t, tc.code, p.Code, tc.name)
+		require.Equal(t, tc.status, p.Status, tc.name)
+	}
+}
+
+// batchClient records the size of every call to service1 and fails the call
+// with the last string of the request, so that nothing reaches the database.
+type batchClient struct {
+	grpcclient.HasherClient
+	last  string
+	sizes []int
+}
+
+func (c *batchClient) Calculate(_ context.Context, in []string) ([]string, error) {
+	c.sizes = append(c.sizes, len(in))
+	if in[len(in)-1] == c.last {
+		return nil, errors.New("stop")
+	}
+	return make([]string, len(in)), nil
+}
+
+func (c *batchClient) CalculateStream(context.Context) (grpcclient.HashStream, error) {
+	return discardStream{}, nil
+}
+
+type discardStream struct{ io.Writer }
+
+func (discardStream) Write(p []byte) (int, error) { return len(p), nil }
+func (discardStream) EndItem() error              { return nil }
+func (discardStream) Finish() ([]string, error)   { return nil, errors.New("stop") }
+
+func TestLimits_GRPCBatches(t *testing.T) {
+	cases := []struct {
+		name       string
+		batchItems int
+		in         []string
+		want       []int
+	}{
+		{"by service1 max_items", 2, []string{"a", "b", "c", "d", "e"}, []int{2, 2, 1}},
+		// пустые строки тоже занимают место в сообщении gRPC
+		{"empty strings by bytes", 0, append(make([]string, 600000-1), "last"), []int{524288, 75712}},
+	}
+	for _, tc := range cases {
+		hc := &batchClient{last: tc.in[len(tc.in)-1]}
+		r := newRouter(&api.Handlers{HashClient: hc, Limits: api.Limits{BatchItems: tc.batchItems}})
+
+		body, err := json.Marshal(tc.in)
+		require.NoError(t, err)
+		w := serve(r, http.MethodPost, "/send", "application/json", string(body))
+		require.Equal(t, http.StatusInternalServerError, w.Code, tc.name)
+		require.Equal(t, tc.want, hc.sizes, tc.name)
+	}
+}
+
+func TestLimits_Upload(t *testing.T) {
+	r := newRouter(&api.Handlers{HashClient: &batchClient{last: "abc"}, Limits: api.Limits{MaxUploadBytes: 1024, BatchItems: 2}})
+
+	files := func(sizes ...int) (string, string) {
+		var buf bytes.Buffer
+		mpw := multipart.NewWriter(&buf)
+		for i, n := range sizes {
+			fw, err := mpw.CreateFormFile("file", string(rune('a'+i))+".txt")
+			require.NoError(t, err)
+			_, err = fw.Write(bytes.Repeat([]byte("x"), n))
+			require.NoError(t, err)
+		}
+		require.NoError(t, mpw.Close())
+		return mpw.FormDataContentType(), buf.String()
+	}
+
+	ct, body := files(2000)
+	w := serve(r, http.MethodPost, "/send/files", ct, body)
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	// все файлы идут одним стримом и не могут превышать max_items service1
+	ct, body = files(1, 1, 1)
+	w = serve(r, http.MethodPost, "/send/files", ct, body)
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	w = serve(r, http.MethodPost, "/import", "text/csv", "value\n"+strings.Repeat("abc\n", 500))
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	var imp struct {
+		Imported int `json:"imported"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &imp))
+	require.Zero(t, imp.Imported)
+}
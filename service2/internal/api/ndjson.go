@@ -0,0 +1,208 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"service2/internal/mw"
+)
+
+const (
+	mimeNDJSON = "application/x-ndjson"
+
+	// ndjsonBatch — сколько строк уходит в service1 одним вызовом
+	ndjsonBatch = 1000
+	// ndjsonLinger — сколько ждём добора батча, прежде чем отправить неполный
+	ndjsonLinger = 200 * time.Millisecond
+	// streamIdleTimeout — сколько ждём следующую порцию тела от клиента или
+	// запись ответа в потоковых эндпоинтах
+	streamIdleTimeout = 30 * time.Second
+	// ndjsonMaxLine — максимальная длина одной строки входа
+	ndjsonMaxLine = 1 << 20
+)
+
+// sendNDJSON handles POST /send with an NDJSON body: one JSON string per line.
+// Lines are hashed in batches, and each saved hash is written back as its own
+// {"id":..,"hash":..} line, flushed as soon as its batch is stored. Neither the
+// request nor the response is held in memory whole, so feeds of any length can
+// be piped through. Once the first line of the response is sent the status can
+// no longer change, so later failures are reported as a final {"error":..} line.
+func (h *Handlers) sendNDJSON(c *gin.Context) {
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	reqID := mw.FromContext(ctx)
+
+	rc := http.NewResponseController(c.Writer)
+	// отвечаем, пока клиент ещё дописывает тело
+	_ = rc.EnableFullDuplex()
+
+	// таймауты сервера рассчитаны на обычные запросы, а не на поток; после
+	// выхода из обработчика дедлайн чтения больше не продлевается
+	var deadlineMu sync.Mutex
+	extendRead := func() bool {
+		deadlineMu.Lock()
+		defer deadlineMu.Unlock()
+		if ctx.Err() != nil {
+			return false
+		}
+		_ = rc.SetReadDeadline(time.Now().Add(streamIdleTimeout))
+		return true
+	}
+
+	lines := make(chan string)
+	readErr := make(chan error, 1)
+	readDone := make(chan struct{})
+	go func() {
+		defer close(readDone)
+		defer close(lines)
+		sc := bufio.NewScanner(c.Request.Body)
+		// строка в JSON может быть до 6 раз длиннее самой строки (\uXXXX)
+		sc.Buffer(make([]byte, 0, 64*1024), max(ndjsonMaxLine, 6*h.Limits.MaxItemBytes+2))
+		n := 0
+		for {
+			if !extendRead() {
+				readErr <- ctx.Err()
+				return
+			}
+			if !sc.Scan() {
+				break
+			}
+			n++
+			raw := bytes.TrimSpace(sc.Bytes())
+			if len(raw) == 0 {
+				continue
+			}
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				readErr <- fmt.Errorf("line %d: %w", n, err)
+				return
+			}
+			if h.Limits.tooLong(s) {
+				readErr <- fmt.Errorf("line %d: %w", n, errItemTooLong)
+				return
+			}
+			select {
+			case lines <- s:
+			case <-ctx.Done():
+				readErr <- ctx.Err()
+				return
+			}
+		}
+		readErr <- sc.Err()
+	}()
+	// тело не должно читаться после возврата из обработчика: прерываем
+	// чтение и ждём горутину
+	defer func() {
+		select {
+		case <-readDone:
+			return
+		default:
+		}
+		deadlineMu.Lock()
+		cancel()
+		_ = rc.SetReadDeadline(time.Now())
+		deadlineMu.Unlock()
+		<-readDone
+	}()
+
+	type resp struct {
+		ID   int64  `json:"id"`
+		Hash string `json:"hash"`
+	}
+
+	enc := json.NewEncoder(c.Writer)
+	started := false
+	start := func() {
+		if !started {
+			c.Header("Content-Type", mimeNDJSON)
+			c.Status(http.StatusOK)
+			started = true
+		}
+	}
+	// подробности ошибки остаются в логе, клиент получает только текст статуса
+	fail := func(status int, err error) {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("send ndjson: " + err.Error())
+		if !started {
+			c.Status(status)
+			return
+		}
+		_ = enc.Encode(gin.H{"error": strings.ToLower(http.StatusText(status)), "request_id": reqID})
+		c.Writer.Flush()
+	}
+
+	batch := make([]string, 0, ndjsonBatch)
+	total := 0
+	flush := func() bool {
+		rows, err := h.hashAndStore(ctx, batch, nil)
+		if err != nil {
+			fail(failStatus(c, err), err)
+			return false
+		}
+		start()
+		_ = rc.SetWriteDeadline(time.Now().Add(streamIdleTimeout))
+		for _, r := range rows {
+			if err := enc.Encode(resp{ID: r.ID, Hash: r.Hash}); err != nil {
+				h.Log.WithField("request_id", reqID).WithError(err).Error("send ndjson: write failed")
+				return false
+			}
+		}
+		c.Writer.Flush()
+		total += len(rows)
+		batch = batch[:0]
+		return true
+	}
+
+	h.Log.WithField("request_id", reqID).Info("send ndjson: start")
+
+	linger := time.NewTimer(ndjsonLinger)
+	linger.Stop()
+	defer linger.Stop()
+	for {
+		select {
+		case s, ok := <-lines:
+			if !ok {
+				if err := <-readErr; err != nil {
+					if errors.Is(err, errItemTooLong) {
+						fail(http.StatusUnprocessableEntity, err)
+					} else {
+						fail(http.StatusBadRequest, err)
+					}
+					return
+				}
+				if len(batch) > 0 && !flush() {
+					return
+				}
+				start()
+				h.Log.WithField("request_id", reqID).WithField("saved", total).Info("send ndjson: done")
+				return
+			}
+			if len(batch) == 0 {
+				linger.Reset(ndjsonLinger)
+			}
+			batch = append(batch, s)
+			if len(batch) == ndjsonBatch {
+				linger.Stop()
+				if !flush() {
+					return
+				}
+			}
+		case <-linger.C:
+			if len(batch) > 0 && !flush() {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package api_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/api"
+)
+
+const mimeNDJSON = "application/x-ndjson"
+
+type idHash struct {
+	ID   int64  `json:"id"`
+	Hash string `json:"hash"`
+}
+
+// ndjsonLine is an output line: a saved hash or the final error.
+type ndjsonLine struct {
+	ID        int64  `json:"id"`
+	Hash      string `json:"hash"`
+	Error     string `json:"error"`
+	RequestID string `json:"request_id"`
+}
+
+func ndjsonLines(t *testing.T, body string) []ndjsonLine {
+	t.Helper()
+	var out []ndjsonLine
+	sc := bufio.NewScanner(strings.NewReader(body))
+	for sc.Scan() {
+		var l ndjsonLine
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &l), sc.Text())
+		out = append(out, l)
+	}
+	require.NoError(t, sc.Err())
+	return out
+}
+
+// ndjsonInput returns n lines "s1".."sn".
+func ndjsonInput(n int) string {
+	var b strings.Builder
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&b, "\"s%d\"\n", i)
+	}
+	return b.String()
+}
+
+func TestSendNDJSON(t *testing.T) {
+	h := newHandlers(&memStore{})
+
+	// пустые строки пропускаются, ответ в порядке входа
+	w := call(h.Send, http.MethodPost, "/send", mimeNDJSON, "\"b\"\n\n  \"a\"  \n\"b\"")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, mimeNDJSON, w.Header().Get("Content-Type"))
+	require.Equal(t, []ndjsonLine{{ID: 1, Hash: "h:b"}, {ID: 2, Hash: "h:a"}, {ID: 3, Hash: "h:b"}}, ndjsonLines(t, w.Body.String()))
+
+	w = call(h.Send, http.MethodPost, "/send", mimeNDJSON, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, mimeNDJSON, w.Header().Get("Content-Type"))
+	require.Empty(t, w.Body.String())
+
+	// несколько батчей идут подряд, без пропусков и перестановок
+	w = call(h.Send, http.MethodPost, "/send", mimeNDJSON, ndjsonInput(2500))
+	require.Equal(t, http.StatusOK, w.Code)
+	lines := ndjsonLines(t, w.Body.String())
+	require.Len(t, lines, 2500)
+	for i, l := range lines {
+		require.Equal(t, ndjsonLine{ID: int64(4 + i), Hash: fmt.Sprintf("h:s%d", i+1)}, l)
+	}
+}
+
+func TestSendNDJSON_Errors(t *testing.T) {
+	// до первой строки ответа ошибка передаётся статусом
+	cases := []struct {
+		name, body string
+		want       int
+	}{
+		{"not a string", "\"a\"\n{\"x\":1}\n", http.StatusBadRequest},
+		{"too long", "\"a\"\n\"abcd\"\n", http.StatusUnprocessableEntity},
+	}
+	for _, tc := range cases {
+		h := newHandlers(&memStore{})
+		h.Limits = api.Limits{MaxItemBytes: 3}
+		w := call(h.Send, http.MethodPost, "/send", mimeNDJSON, tc.body)
+		require.Equal(t, tc.want, w.Code, tc.name)
+		require.Empty(t, w.Body.String(), tc.name)
+	}
+
+	// после неё — последней строкой с текстом статуса и ID запроса, без
+	// подробностей ошибки
+	later := []struct {
+		name, body string
+		failInsert int
+		want       string
+	}{
+		{"not a string", ndjsonInput(1000) + "{\"x\":1}\n", 0, "bad request"},
+		{"too long", ndjsonInput(1000) + "\"abcdef\"\n", 0, "unprocessable entity"},
+		{"db failed", ndjsonInput(1001), 2, "internal server error"},
+	}
+	for _, tc := range later {
+		h := newHandlers(&memStore{failInsert: tc.failInsert})
+		h.Limits = api.Limits{MaxItemBytes: len("s1000")}
+		w := call(h.Send, http.MethodPost, "/send", mimeNDJSON, tc.body)
+		require.Equal(t, http.StatusOK, w.Code, tc.name)
+		lines := ndjsonLines(t, w.Body.String())
+		require.Len(t, lines, 1001, tc.name)
+		require.Equal(t, ndjsonLine{ID: 1000, Hash: "h:s1000"}, lines[999], tc.name)
+		require.Equal(t, ndjsonLine{Error: tc.want, RequestID: testRequestID}, lines[1000], tc.name)
+	}
+}
+
+// TestSendNDJSON_Stream feeds the body line by line over a real connection:
+// each hash comes back before the next line is sent, and the handler returns
+// once the client goes away mid-body.
+func TestSendNDJSON_Stream(t *testing.T) {
+	h := newHandlers(&memStore{})
+	r := newRouter(h)
+	returned := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.ServeHTTP(w, req)
+		returned <- struct{}{}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/send", pr)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", mimeNDJSON)
+
+	// ответ приходит, только когда уже отправлена первая строка
+	type result struct {
+		resp *http.Response
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := srv.Client().Do(req)
+		done <- result{resp, err}
+	}()
+	_, err = io.WriteString(pw, "\"a\"\n")
+	require.NoError(t, err)
+	res := <-done
+	require.NoError(t, res.err)
+	resp := res.resp
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	out := bufio.NewReader(resp.Body)
+	for i, s := range []string{"a", "b", "c"} {
+		if i > 0 {
+			_, err = io.WriteString(pw, fmt.Sprintf("%q\n", s))
+			require.NoError(t, err)
+		}
+		line, err := out.ReadBytes('\n')
+		require.NoError(t, err)
+		var got idHash
+		require.NoError(t, json.Unmarshal(line, &got))
+		require.Equal(t, idHash{ID: int64(i + 1), Hash: "h:" + s}, got)
+	}
+
+	// клиент уходит, не дописав тело
+	cancel()
+	select {
+	case <-returned:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler did not return after the client went away")
+	}
+}
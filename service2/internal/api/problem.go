@@ -0,0 +1,56 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"service2/internal/mw"
+)
+
+// Машиночитаемые коды ошибок /v2. Клиенты опираются на них, а не на текст
+// detail, поэтому существующие коды не переименовываются.
+const (
+	CodeBadRequest  = "bad_request"
+	CodeInvalidItem = "invalid_item"
+	CodeInvalidID   = "invalid_id"
+	CodeNotFound    = "not_found"
+	CodeDeleted     = "deleted"
+	CodeInternal    = "internal"
+
+	CodeQuotaExceeded = "quota_exceeded"
+	CodeUnavailable   = "service_unavailable"
+)
+
+// ItemError describes why a single element of a batch request was not
+// processed.
+type ItemError = mw.ItemError
+
+// envelope wraps every successful /v2 response. Errors lists the elements of
+// a batch that failed while the rest succeeded.
+type envelope struct {
+	Data   any          `json:"data"`
+	Errors []ItemError  `json:"errors,omitempty"`
+	Meta   envelopeMeta `json:"meta"`
+}
+
+type envelopeMeta struct {
+	RequestID   string `json:"request_id"`
+	Count       int    `json:"count"`
+	NextAfterID int64  `json:"next_after_id,omitempty"`
+}
+
+// problem aborts the request with an application/problem+json body.
+func problem(c *gin.Context, status int, code, detail string, items []ItemError) {
+	mw.AbortWithProblem(c, status, code, detail, items)
+}
+
+// respond writes data wrapped in the /v2 envelope.
+func respond(c *gin.Context, status int, data any, count int, items []ItemError) {
+	c.JSON(status, envelope{
+		Data:   data,
+		Errors: items,
+		Meta: envelopeMeta{
+			RequestID: mw.FromContext(c.Request.Context()),
+			Count:     count,
+		},
+	})
+}
@@ -1,23 +1,83 @@
 package api
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"service2/internal/health"
 	"service2/internal/mw"
 )
 
-func NewRouter(h *Handlers, log *logrus.Logger) *gin.Engine {
+// NewRouter builds the HTTP API. auth authenticates and limit, if not nil,
+// rate-limits every route except /metrics, which is scraped by Prometheus,
+// and the probes /healthz and /readyz served by probes, if not nil.
+// The admin routes, /admin and DELETE /v2/hashes, are mounted only if admin
+// is set: without authentication anyone would reach them.
+func NewRouter(h *Handlers, log *logrus.Logger, auth, limit gin.HandlerFunc, probes *health.Checker, admin bool) *gin.Engine {
 	r := gin.New()
-	r.Use(gin.Recovery())
+	r.Use(gin.CustomRecovery(func(c *gin.Context, err any) {
+		// http.ErrAbortHandler обрывает ответ, его должен получить net/http
+		if err == http.ErrAbortHandler {
+			panic(err)
+		}
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
 	r.Use(mw.RequestID())
 	r.Use(mw.HTTPLogger(log))
 	r.Use(mw.Metrics())
 
-	r.POST("/send", h.Send)
-	r.GET("/check", h.Check)
 	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	if probes != nil {
+		r.GET("/healthz", probes.Live)
+		r.GET("/readyz", probes.Ready)
+	}
+
+	authed := r.Group("/", auth)
+	if limit != nil {
+		authed.Use(limit)
+	}
+
+	// /v1 — исходный API; те же маршруты без префикса оставлены для
+	// совместимости со старыми клиентами
+	registerV1(authed, h)
+	registerV1(authed.Group("/v1"), h)
+
+	read := mw.RequireScope(mw.ScopeHashRead)
+	write := mw.RequireScope(mw.ScopeHashWrite)
+
+	v2 := authed.Group("/v2")
+	v2.POST("/send", write, h.SendV2)
+	v2.GET("/check", read, h.CheckV2)
+	v2.POST("/check", read, h.CheckV2)
+	v2.GET("/hashes", read, h.ListV2)
+
+	if !admin {
+		return r
+	}
+	v2.DELETE("/hashes", mw.RequireScope(mw.ScopeAdmin), h.DeleteV2)
+
+	adm := authed.Group("/admin", mw.RequireScope(mw.ScopeAdmin))
+	adm.POST("/keys", h.CreateAPIKey)
+	adm.DELETE("/keys/:id", h.RevokeAPIKey)
+	adm.GET("/tenants/:tenant/quota", h.GetQuota)
+	adm.PUT("/tenants/:tenant/quota", h.SetQuota)
+	adm.GET("/config", h.GetConfig)
 
 	return r
 }
+
+func registerV1(r gin.IRoutes, h *Handlers) {
+	read := mw.RequireScope(mw.ScopeHashRead)
+	write := mw.RequireScope(mw.ScopeHashWrite)
+
+	r.POST("/send", write, h.Send)
+	r.POST("/send/files", write, h.SendFiles)
+	r.POST("/import", write, h.Import)
+	r.GET("/check", read, h.Check)
+	r.POST("/check", read, h.Check)
+	r.GET("/hashes", read, h.List)
+	r.GET("/export", read, h.Export)
+}
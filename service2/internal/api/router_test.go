@@ -0,0 +1,37 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/api"
+	"service2/internal/mw"
+)
+
+func TestRouter_AdminRoutes(t *testing.T) {
+	adminRoutes := []struct{ method, target string }{
+		{http.MethodPost, "/admin/keys"},
+		{http.MethodGet, "/admin/config"},
+		{http.MethodPut, "/admin/tenants/default/quota"},
+		{http.MethodDelete, "/v2/hashes?ids=1"},
+	}
+
+	// без аутентификации маршрутов admin нет вовсе
+	r := newLimitedRouter(api.Limits{})
+	for _, route := range adminRoutes {
+		w := serve(r, route.method, route.target, "application/json", `{}`)
+		require.Equal(t, http.StatusNotFound, w.Code, route.target)
+	}
+
+	// с аутентификацией они есть, но требуют права admin
+	log := logrus.New()
+	user := mw.Principal{Tenant: "default", Subject: "etl", Scopes: []string{mw.ScopeHashRead, mw.ScopeHashWrite}}
+	r = api.NewRouter(&api.Handlers{Log: log}, log, mw.Auth(false, user), nil, nil, true)
+	for _, route := range adminRoutes {
+		w := serve(r, route.method, route.target, "application/json", `{}`)
+		require.Equal(t, http.StatusForbidden, w.Code, route.target)
+	}
+}
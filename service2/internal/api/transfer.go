@@ -0,0 +1,401 @@
+package api
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+
+	"service2/internal/mw"
+	"service2/internal/storage"
+)
+
+const (
+	// importBatch — сколько строк CSV уходит в service1 одним вызовом
+	importBatch = 1000
+	// listDefaultLimit и listMaxLimit ограничивают размер страницы GET /hashes
+	listDefaultLimit = 100
+	listMaxLimit     = 1000
+)
+
+// hashRecord is the full representation of a stored hash used by listing and
+// export.
+type hashRecord struct {
+	ID          int64     `json:"id" parquet:"id"`
+	Hash        string    `json:"hash" parquet:"hash"`
+	Source      string    `json:"source,omitempty" parquet:"source,optional"`
+	Label       string    `json:"label,omitempty" parquet:"label,optional"`
+	Filename    string    `json:"filename,omitempty" parquet:"filename,optional"`
+	Size        int64     `json:"size,omitempty" parquet:"size,optional"`
+	ContentType string    `json:"content_type,omitempty" parquet:"content_type,optional"`
+	CreatedAt   time.Time `json:"created_at" parquet:"created_at,timestamp"`
+}
+
+func toRecord(r storage.HashRow) hashRecord {
+	return hashRecord{
+		ID:          r.ID,
+		Hash:        r.Hash,
+		Source:      r.Source,
+		Label:       r.Label,
+		Filename:    r.Filename,
+		Size:        r.Size,
+		ContentType: r.ContentType,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// POST /import
+// body: text/csv с заголовком; колонка value обязательна, source и label — нет;
+// тело не больше Limits.MaxUploadBytes (413)
+// 200: {"imported":2,"first_id":38,"last_id":39}
+// Строки сохраняются пачками по importBatch, каждая в своей транзакции. При
+// ошибке в середине файла уже сохранённые пачки остаются, и ответ с ошибкой
+// сообщает, сколько их: {"error":"...","imported":1000,"first_id":38,
+// "last_id":1037,"request_id":"..."}; продолжить можно со строки imported+1.
+func (h *Handlers) Import(c *gin.Context) {
+	h.limitUpload(c)
+	ctx := c.Request.Context()
+	reqID := mw.FromContext(ctx)
+	rc := http.NewResponseController(c.Writer)
+
+	var firstID, lastID int64
+	imported := 0
+	failed := func(status int, msg string, err error) {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).WithField("imported", imported).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("import: " + msg)
+		c.JSON(status, gin.H{
+			"error":      strings.ToLower(http.StatusText(status)),
+			"imported":   imported,
+			"first_id":   firstID,
+			"last_id":    lastID,
+			"request_id": reqID,
+		})
+	}
+	badRequest := func(err error) {
+		failed(badBodyStatus(err), "bad request", err)
+	}
+
+	r := csv.NewReader(c.Request.Body)
+	r.ReuseRecord = true
+	header, err := r.Read()
+	if err != nil {
+		badRequest(err)
+		return
+	}
+	valueCol, sourceCol, labelCol := -1, -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "value":
+			valueCol = i
+		case "source":
+			sourceCol = i
+		case "label":
+			labelCol = i
+		}
+	}
+	if valueCol < 0 {
+		badRequest(errors.New("csv header has no value column"))
+		return
+	}
+
+	h.Log.WithField("request_id", reqID).Info("import: start")
+
+	values := make([]string, 0, importBatch)
+	meta := make([]storage.HashRow, 0, importBatch)
+	flush := func() error {
+		rows, err := h.hashAndStore(ctx, values, meta)
+		if err != nil {
+			return err
+		}
+		if firstID == 0 {
+			firstID = rows[0].ID
+		}
+		lastID = rows[len(rows)-1].ID
+		imported += len(rows)
+		values, meta = values[:0], meta[:0]
+		return nil
+	}
+
+	var flushErr error
+	for flushErr == nil {
+		// таймауты сервера рассчитаны на обычные запросы, а не на большой файл
+		_ = rc.SetReadDeadline(time.Now().Add(streamIdleTimeout))
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			badRequest(err)
+			return
+		}
+
+		if h.Limits.tooLong(rec[valueCol]) {
+			line, _ := r.FieldPos(valueCol)
+			failed(http.StatusUnprocessableEntity, "bad request", fmt.Errorf("line %d: %w", line, errItemTooLong))
+			return
+		}
+
+		row := storage.HashRow{}
+		if sourceCol >= 0 {
+			row.Source = rec[sourceCol]
+		}
+		if labelCol >= 0 {
+			row.Label = rec[labelCol]
+		}
+		values = append(values, rec[valueCol])
+		meta = append(meta, row)
+
+		if len(values) == importBatch {
+			flushErr = flush()
+		}
+	}
+	if flushErr == nil && len(values) > 0 {
+		flushErr = flush()
+	}
+	if flushErr != nil {
+		failed(failStatus(c, flushErr), flushErr.Error(), flushErr)
+		return
+	}
+
+	h.Log.WithField("request_id", reqID).WithField("imported", imported).Info("import: done")
+	c.JSON(http.StatusOK, gin.H{"imported": imported, "first_id": firstID, "last_id": lastID})
+}
+
+// GET /hashes?after_id=&to_id=&source=&label=&since=&until=&limit=
+// 200: [{"id":38,"hash":"...","source":"...","created_at":"..."}]
+// Следующая страница запрашивается с after_id = id последнего элемента.
+func (h *Handlers) List(c *gin.Context) {
+	f, err := parseListFilter(c)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	limit := listDefaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+		limit = min(limit, listMaxLimit)
+	}
+
+	rows, err := h.Store.List(c.Request.Context(), f, limit)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", mw.FromContext(c.Request.Context())).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("list: db failed")
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	out := make([]hashRecord, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, toRecord(r))
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// GET /export?format=csv|ndjson|parquet и те же фильтры, что у GET /hashes
+// Строки читаются курсором из Postgres и сразу пишутся в ответ. Если выгрузка
+// оборвалась после начала ответа, ndjson заканчивается строкой
+// {"error":"...","request_id":"..."}, а для csv и parquet соединение
+// разрывается без завершения тела, чтобы клиент не принял неполный файл.
+func (h *Handlers) Export(c *gin.Context) {
+	ctx := c.Request.Context()
+	reqID := mw.FromContext(ctx)
+
+	f, err := parseListFilter(c)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	format := c.DefaultQuery("format", "csv")
+	var w exportWriter
+	switch format {
+	case "csv":
+		w = newCSVExport(c.Writer)
+		c.Header("Content-Type", "text/csv")
+	case "ndjson":
+		w = newNDJSONExport(c.Writer)
+		c.Header("Content-Type", mimeNDJSON)
+	case "parquet":
+		w = newParquetExport(c.Writer)
+		c.Header("Content-Type", "application/vnd.apache.parquet")
+	default:
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="hashes.%s"`, format))
+	c.Status(http.StatusOK)
+
+	h.Log.WithField("request_id", reqID).WithField("format", format).Info("export: start")
+
+	rc := http.NewResponseController(c.Writer)
+	n := 0
+	err = h.Store.Export(ctx, f, func(r storage.HashRow) error {
+		if n%importBatch == 0 {
+			_ = rc.SetWriteDeadline(time.Now().Add(streamIdleTimeout))
+		}
+		n++
+		return w.Write(toRecord(r))
+	})
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).WithField("exported", n).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("export: failed")
+		// пока ничего не отправлено, ещё можно вернуть ошибку статусом
+		switch {
+		case !c.Writer.Written():
+			c.Writer.Header().Del("Content-Disposition")
+			c.Status(http.StatusInternalServerError)
+		case format == "ndjson":
+			_ = w.Close()
+			_ = json.NewEncoder(c.Writer).Encode(gin.H{"error": "export failed", "request_id": reqID})
+		default:
+			abortResponse(c)
+		}
+		return
+	}
+
+	h.Log.WithField("request_id", reqID).WithField("exported", n).Info("export: done")
+}
+
+// abortResponse closes the connection of a response that has already started,
+// without ending its body, so that the client sees it as incomplete.
+func abortResponse(c *gin.Context) {
+	if conn, _, err := http.NewResponseController(c.Writer).Hijack(); err == nil {
+		_ = conn.Close()
+		return
+	}
+	// соединение не перехватить (HTTP/2): net/http сам сбросит поток
+	panic(http.ErrAbortHandler)
+}
+
+func parseListFilter(c *gin.Context) (storage.ListFilter, error) {
+	f := storage.ListFilter{Tenant: tenantOf(c.Request.Context())}
+	var err error
+	parseID := func(key string) int64 {
+		raw := c.Query(key)
+		if raw == "" || err != nil {
+			return 0
+		}
+		var v int64
+		v, err = strconv.ParseInt(raw, 10, 64)
+		return v
+	}
+	parseTime := func(key string) time.Time {
+		raw := c.Query(key)
+		if raw == "" || err != nil {
+			return time.Time{}
+		}
+		var v time.Time
+		v, err = time.Parse(time.RFC3339, raw)
+		return v
+	}
+	f.AfterID = parseID("after_id")
+	f.ToID = parseID("to_id")
+	f.Since = parseTime("since")
+	f.Until = parseTime("until")
+	f.Source = c.Query("source")
+	f.Label = c.Query("label")
+	return f, err
+}
+
+type exportWriter interface {
+	Write(hashRecord) error
+	Close() error
+}
+
+var csvExportHeader = []string{"id", "hash", "source", "label", "filename", "size", "content_type", "created_at"}
+
+type csvExport struct {
+	w      *csv.Writer
+	header bool
+	rec    []string
+}
+
+func newCSVExport(w io.Writer) *csvExport {
+	return &csvExport{w: csv.NewWriter(w), rec: make([]string, len(csvExportHeader))}
+}
+
+func (e *csvExport) Write(r hashRecord) error {
+	if !e.header {
+		if err := e.w.Write(csvExportHeader); err != nil {
+			return err
+		}
+		e.header = true
+	}
+	e.rec[0] = strconv.FormatInt(r.ID, 10)
+	e.rec[1] = r.Hash
+	e.rec[2] = r.Source
+	e.rec[3] = r.Label
+	e.rec[4] = r.Filename
+	e.rec[5] = ""
+	if r.Filename != "" {
+		e.rec[5] = strconv.FormatInt(r.Size, 10)
+	}
+	e.rec[6] = r.ContentType
+	e.rec[7] = r.CreatedAt.UTC().Format(time.RFC3339)
+	return e.w.Write(e.rec)
+}
+
+func (e *csvExport) Close() error {
+	if !e.header {
+		_ = e.w.Write(csvExportHeader)
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonExport struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONExport(w io.Writer) *ndjsonExport {
+	buf := bufio.NewWriter(w)
+	return &ndjsonExport{buf: buf, enc: json.NewEncoder(buf)}
+}
+
+func (e *ndjsonExport) Write(r hashRecord) error {
+	return e.enc.Encode(r)
+}
+
+func (e *ndjsonExport) Close() error {
+	return e.buf.Flush()
+}
+
+type parquetExport struct {
+	w *parquet.GenericWriter[hashRecord]
+}
+
+func newParquetExport(w io.Writer) *parquetExport {
+	return &parquetExport{w: parquet.NewGenericWriter[hashRecord](w)}
+}
+
+func (e *parquetExport) Write(r hashRecord) error {
+	_, err := e.w.Write([]hashRecord{r})
+	return err
+}
+
+func (e *parquetExport) Close() error {
+	return e.w.Close()
+}
@@ -0,0 +1,167 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/storage"
+)
+
+type importResult struct {
+	Error     string `json:"error"`
+	Imported  int    `json:"imported"`
+	FirstID   int64  `json:"first_id"`
+	LastID    int64  `json:"last_id"`
+	RequestID string `json:"request_id"`
+}
+
+// csvInput returns a CSV with a header and n rows value=vI, source=srcI.
+func csvInput(n int) string {
+	var b strings.Builder
+	b.WriteString("Source, VALUE ,extra\n")
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&b, "src%d,v%d,x\n", i, i)
+	}
+	return b.String()
+}
+
+func TestImport(t *testing.T) {
+	store := &memStore{}
+	h := newHandlers(store)
+
+	w := call(h.Import, http.MethodPost, "/import", "text/csv", csvInput(2500))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, importResult{Imported: 2500, FirstID: 1, LastID: 2500}, decode[importResult](t, w))
+	// пачками по 1000 строк
+	require.Equal(t, 3, store.inserts)
+	require.Len(t, store.rows, 2500)
+	r := store.rows[1499]
+	require.Equal(t, "h:v1500", r.Hash)
+	require.Equal(t, "src1500", r.Source)
+	require.Empty(t, r.Label)
+
+	w = call(h.Import, http.MethodPost, "/import", "text/csv", "source,label\na,b\n")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, importResult{Error: "bad request", RequestID: testRequestID}, decode[importResult](t, w))
+
+	w = call(h.Import, http.MethodPost, "/import", "text/csv", "")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestImport_PartialFailure(t *testing.T) {
+	// сохранённые пачки остаются, ответ говорит, с какой строки продолжить
+	cases := []struct {
+		name       string
+		body       string
+		failInsert int
+		want       importResult
+	}{
+		{"db failed", csvInput(2500), 2,
+			importResult{Error: "internal server error", Imported: 1000, FirstID: 1, LastID: 1000, RequestID: testRequestID}},
+		{"bad csv row", csvInput(1500) + "a,b\n" + csvInput(10)[len("Source, VALUE ,extra\n"):], 0,
+			importResult{Error: "bad request", Imported: 1000, FirstID: 1, LastID: 1000, RequestID: testRequestID}},
+		{"bad first batch", "value\n\"a\n", 0,
+			importResult{Error: "bad request", RequestID: testRequestID}},
+	}
+	for _, tc := range cases {
+		store := &memStore{failInsert: tc.failInsert}
+		h := newHandlers(store)
+		w := call(h.Import, http.MethodPost, "/import", "text/csv", tc.body)
+		require.NotEqual(t, http.StatusOK, w.Code, tc.name)
+		require.Equal(t, tc.want, decode[importResult](t, w), tc.name)
+		require.Len(t, store.rows, tc.want.Imported, tc.name)
+	}
+}
+
+// exportStore holds n rows of the default tenant, odd ones with source "odd",
+// and a row of another tenant.
+func exportStore(t *testing.T, n int) *memStore {
+	store := &memStore{}
+	rows := make([]storage.HashRow, n)
+	for i := range rows {
+		rows[i].Hash = fmt.Sprintf("hash%d", i+1)
+		if i%2 == 0 {
+			rows[i].Source = "odd"
+		}
+	}
+	_, err := store.InsertRows(context.Background(), storage.DefaultTenant, rows)
+	require.NoError(t, err)
+	_, err = store.InsertHashes(context.Background(), "other", []string{"foreign"})
+	require.NoError(t, err)
+	return store
+}
+
+func TestExport(t *testing.T) {
+	h := newHandlers(exportStore(t, 4))
+
+	w := call(h.Export, http.MethodGet, "/export?source=odd", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	require.Equal(t, `attachment; filename="hashes.csv"`, w.Header().Get("Content-Disposition"))
+	require.Equal(t, "id,hash,source,label,filename,size,content_type,created_at\n"+
+		"1,hash1,odd,,,,,2026-01-01T00:00:00Z\n"+
+		"3,hash3,odd,,,,,2026-01-01T00:00:00Z\n", w.Body.String())
+
+	// пустая выгрузка — только заголовок
+	w = call(h.Export, http.MethodGet, "/export?format=csv&after_id=4", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "id,hash,source,label,filename,size,content_type,created_at\n", w.Body.String())
+
+	w = call(h.Export, http.MethodGet, "/export?format=ndjson&after_id=1&to_id=3", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, mimeNDJSON, w.Header().Get("Content-Type"))
+	require.Equal(t, `{"id":2,"hash":"hash2","created_at":"2026-01-01T00:00:00Z"}`+"\n"+
+		`{"id":3,"hash":"hash3","source":"odd","created_at":"2026-01-01T00:00:00Z"}`+"\n", w.Body.String())
+
+	w = call(h.Export, http.MethodGet, "/export?format=parquet", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.True(t, strings.HasPrefix(w.Body.String(), "PAR1"))
+	require.True(t, strings.HasSuffix(w.Body.String(), "PAR1"))
+
+	for _, target := range []string{"/export?format=xml", "/export?after_id=x", "/export?since=yesterday"} {
+		w = call(h.Export, http.MethodGet, target, "", "")
+		require.Equal(t, http.StatusBadRequest, w.Code, target)
+	}
+}
+
+func TestExport_Failure(t *testing.T) {
+	// пока ответ не начат, ошибка передаётся статусом
+	store := exportStore(t, 3000)
+	store.failExport = 1
+	h := newHandlers(store)
+	w := call(h.Export, http.MethodGet, "/export?format=ndjson", "", "")
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Empty(t, w.Header().Get("Content-Disposition"))
+	require.Empty(t, w.Body.String())
+
+	// ndjson после начала ответа заканчивается строкой с ошибкой
+	store.failExport = 2000
+	w = call(h.Export, http.MethodGet, "/export?format=ndjson", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	lines := ndjsonLines(t, w.Body.String())
+	require.Len(t, lines, 2001)
+	require.Equal(t, int64(2000), lines[1999].ID)
+	require.Equal(t, ndjsonLine{Error: "export failed", RequestID: testRequestID}, lines[2000])
+
+	// csv обрывается без конца тела, клиент видит неполный ответ
+	srv := httptest.NewServer(newRouter(h))
+	defer srv.Close()
+	resp, err := srv.Client().Get(srv.URL + "/export?format=csv")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, err = io.ReadAll(resp.Body)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	_ = resp.Body.Close()
+
+	// parquet копит строки до Close, так что ответ ещё не начат
+	w = call(h.Export, http.MethodGet, "/export?format=parquet", "", "")
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Empty(t, w.Body.String())
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"service2/internal/mw"
+)
+
+// POST /v2/send
+// body: ["str1","str2",...]
+// 200: {"data":[{"index":0,"id":38,"hash":"..."}],"errors":[{"index":1,"code":"invalid_item"}],"meta":{...}}
+// Элементы, не являющиеся строками, попадают в errors, остальные сохраняются.
+func (h *Handlers) SendV2(c *gin.Context) {
+	h.limitBody(c)
+	var raw []json.RawMessage
+	if err := c.ShouldBindJSON(&raw); err != nil {
+		if !h.bodyTooLarge(c, err) {
+			problem(c, http.StatusBadRequest, CodeBadRequest, "body must be a JSON array of strings", nil)
+		}
+		return
+	}
+	if h.tooManyItemsV2(c, len(raw)) {
+		return
+	}
+
+	in := make([]string, 0, len(raw))
+	index := make([]int, 0, len(raw))
+	var itemErrs []ItemError
+	for i, r := range raw {
+		var s string
+		if len(r) == 0 || r[0] != '"' || json.Unmarshal(r, &s) != nil {
+			itemErrs = append(itemErrs, ItemError{Index: i, Code: CodeInvalidItem, Detail: "item must be a string"})
+			continue
+		}
+		if h.Limits.tooLong(s) {
+			itemErrs = append(itemErrs, ItemError{Index: i, Code: CodeItemTooLong, Detail: h.Limits.itemTooLongDetail()})
+			continue
+		}
+		in = append(in, s)
+		index = append(index, i)
+	}
+	if len(in) == 0 && len(itemErrs) > 0 {
+		problem(c, http.StatusUnprocessableEntity, CodeInvalidItem, "no valid items in request", itemErrs)
+		return
+	}
+
+	type item struct {
+		Index int    `json:"index"`
+		ID    int64  `json:"id"`
+		Hash  string `json:"hash"`
+	}
+	out := make([]item, 0, len(in))
+	if len(in) > 0 {
+		reqID := mw.FromContext(c.Request.Context())
+		h.Log.WithField("request_id", reqID).WithField("count", len(in)).Info("send v2: hashing")
+
+		rows, err := h.hashAndStore(c.Request.Context(), in, nil)
+		if err != nil {
+			werr := errors.WithStack(err)
+			h.Log.WithField("request_id", reqID).
+				WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+				Error("send v2: " + err.Error())
+			switch status := failStatus(c, err); status {
+			case http.StatusTooManyRequests:
+				problem(c, status, mw.CodeRateLimited, "daily quota of hashed strings exceeded", nil)
+			case http.StatusForbidden:
+				problem(c, status, CodeQuotaExceeded, "tenant storage quota exceeded", nil)
+			case http.StatusServiceUnavailable:
+				problem(c, status, CodeUnavailable, "hashing service is unavailable, retry later", nil)
+			default:
+				problem(c, status, CodeInternal, "failed to hash and store items", nil)
+			}
+			return
+		}
+		for i, r := range rows {
+			out = append(out, item{Index: index[i], ID: r.ID, Hash: r.Hash})
+		}
+	}
+
+	respond(c, http.StatusOK, out, len(out), itemErrs)
+}
+
+// GET /v2/check?ids=1&ids=2 или /v2/check?ids=1,2
+// POST /v2/check, body: {"ids":[1,2],"mode":"status"}
+// 200: {"data":[{"id":1,"hash":"..."}],"errors":[{"index":1,"code":"not_found"}],"meta":{...}}
+// Найденные хэши возвращаются в порядке запроса; пустой data — не ошибка.
+// С mode=status data содержит каждый запрошенный ID со статусом.
+func (h *Handlers) CheckV2(c *gin.Context) {
+	var req checkRequest
+	// index[i] — позиция req.IDs[i] в исходном запросе
+	var index []int
+	var itemErrs []ItemError
+	if c.Request.Method == http.MethodPost {
+		h.limitBody(c)
+		if err := c.ShouldBindJSON(&req); err != nil {
+			if !h.bodyTooLarge(c, err) {
+				problem(c, http.StatusBadRequest, CodeBadRequest, "body must be {\"ids\":[...]}", nil)
+			}
+			return
+		}
+		index = make([]int, len(req.IDs))
+		for i := range index {
+			index[i] = i
+		}
+	} else {
+		req.Mode = c.Query("mode")
+		for i, s := range queryIDs(c) {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				itemErrs = append(itemErrs, ItemError{Index: i, Code: CodeInvalidID, Detail: fmt.Sprintf("%q is not an integer id", s)})
+				continue
+			}
+			req.IDs = append(req.IDs, v)
+			index = append(index, i)
+		}
+	}
+	if len(req.IDs) == 0 && len(itemErrs) == 0 {
+		problem(c, http.StatusBadRequest, CodeBadRequest, "ids are required", nil)
+		return
+	}
+	if req.Mode != "" && req.Mode != checkModeStatus {
+		problem(c, http.StatusBadRequest, CodeBadRequest, fmt.Sprintf("unknown mode %q", req.Mode), nil)
+		return
+	}
+	if h.tooManyItemsV2(c, len(req.IDs)+len(itemErrs)) {
+		return
+	}
+
+	reqID := mw.FromContext(c.Request.Context())
+	found, err := h.lookup(c.Request.Context(), req.IDs)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("check v2: db failed")
+		problem(c, http.StatusInternalServerError, CodeInternal, "failed to read hashes", nil)
+		return
+	}
+
+	if req.Mode == checkModeStatus {
+		out := checkStatuses(req.IDs, found)
+		respond(c, http.StatusOK, out, len(out), itemErrs)
+		return
+	}
+
+	type item struct {
+		ID   int64  `json:"id"`
+		Hash string `json:"hash"`
+	}
+	out := make([]item, 0, len(found))
+	for i, id := range req.IDs {
+		r, ok := found[id]
+		switch {
+		case !ok:
+			itemErrs = append(itemErrs, ItemError{Index: index[i], Code: CodeNotFound})
+		case r.Deleted:
+			itemErrs = append(itemErrs, ItemError{Index: index[i], Code: CodeDeleted})
+		default:
+			out = append(out, item{ID: id, Hash: r.Hash})
+		}
+	}
+
+	respond(c, http.StatusOK, out, len(out), itemErrs)
+}
+
+// DELETE /v2/hashes?ids=1,2
+// 200: {"data":{"deleted":[1]},"meta":{...}}
+// Удаление мягкое: /check дальше отдаёт такие ID со статусом deleted.
+func (h *Handlers) DeleteV2(c *gin.Context) {
+	var ids []int64
+	for _, s := range queryIDs(c) {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			problem(c, http.StatusBadRequest, CodeInvalidID, fmt.Sprintf("%q is not an integer id", s), nil)
+			return
+		}
+		ids = append(ids, v)
+	}
+	if len(ids) == 0 {
+		problem(c, http.StatusBadRequest, CodeBadRequest, "ids query parameter is required", nil)
+		return
+	}
+
+	reqID := mw.FromContext(c.Request.Context())
+	deleted, err := h.Store.Delete(c.Request.Context(), tenantOf(c.Request.Context()), ids)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", reqID).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("delete v2: db failed")
+		problem(c, http.StatusInternalServerError, CodeInternal, "failed to delete hashes", nil)
+		return
+	}
+	h.uncache(c.Request.Context(), deleted)
+
+	h.Log.WithField("request_id", reqID).WithField("deleted", len(deleted)).Info("delete v2: done")
+	if deleted == nil {
+		deleted = []int64{}
+	}
+	respond(c, http.StatusOK, gin.H{"deleted": deleted}, len(deleted), nil)
+}
+
+// GET /v2/hashes — то же, что GET /hashes, в конверте /v2;
+// meta.next_after_id указывает курсор следующей страницы.
+func (h *Handlers) ListV2(c *gin.Context) {
+	f, err := parseListFilter(c)
+	if err != nil {
+		problem(c, http.StatusBadRequest, CodeBadRequest, err.Error(), nil)
+		return
+	}
+	limit := listDefaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			problem(c, http.StatusBadRequest, CodeBadRequest, "limit must be a positive integer", nil)
+			return
+		}
+		limit = min(limit, listMaxLimit)
+	}
+
+	rows, err := h.Store.List(c.Request.Context(), f, limit)
+	if err != nil {
+		werr := errors.WithStack(err)
+		h.Log.WithField("request_id", mw.FromContext(c.Request.Context())).
+			WithField("stack", fmt.Sprintf("%+v", werr)).WithError(werr).
+			Error("list v2: db failed")
+		problem(c, http.StatusInternalServerError, CodeInternal, "failed to list hashes", nil)
+		return
+	}
+
+	out := make([]hashRecord, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, toRecord(r))
+	}
+	meta := envelopeMeta{RequestID: mw.FromContext(c.Request.Context()), Count: len(out)}
+	if len(rows) == limit {
+		meta.NextAfterID = rows[len(rows)-1].ID
+	}
+	c.JSON(http.StatusOK, envelope{Data: out, Meta: meta})
+}
+
+// bodyTooLarge answers 413 if err comes from a body over the size limit.
+func (h *Handlers) bodyTooLarge(c *gin.Context, err error) bool {
+	if !isTooLarge(err) {
+		return false
+	}
+	problem(c, http.StatusRequestEntityTooLarge, CodePayloadTooLarge,
+		fmt.Sprintf("request body is larger than %d bytes", h.Limits.MaxBodyBytes), nil)
+	return true
+}
+
+// tooManyItemsV2 answers 422 if a request of n items is over the limit.
+func (h *Handlers) tooManyItemsV2(c *gin.Context, n int) bool {
+	if !h.Limits.tooManyItems(n) {
+		return false
+	}
+	problem(c, http.StatusUnprocessableEntity, CodeTooManyItems,
+		fmt.Sprintf("request has %d items, at most %d are allowed", n, h.Limits.MaxItems), nil)
+	return true
+}
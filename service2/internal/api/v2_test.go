@@ -0,0 +1,154 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/api"
+	"service2/internal/grpcclient"
+	"service2/internal/mw"
+	"service2/internal/storage"
+)
+
+// checkStore holds ids 1 ("h:a"), 2 (deleted) and 3 ("h:c").
+func checkStore(t *testing.T) *memStore {
+	t.Helper()
+	store := &memStore{}
+	_, err := store.InsertHashes(context.Background(), storage.DefaultTenant, []string{"h:a", "h:b", "h:c"})
+	require.NoError(t, err)
+	_, err = store.Delete(context.Background(), storage.DefaultTenant, []int64{2})
+	require.NoError(t, err)
+	return store
+}
+
+func TestSendV2(t *testing.T) {
+	h := newHandlers(&memStore{})
+	h.Limits = api.Limits{MaxItemBytes: 4}
+
+	// ошибочные элементы не мешают сохранить остальные
+	w := call(h.SendV2, http.MethodPost, "/v2/send", "application/json", `["a",1,"b",null,"abcde"]`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"data": [{"index":0,"id":1,"hash":"h:a"},{"index":2,"id":2,"hash":"h:b"}],
+		"errors": [
+			{"index":1,"code":"invalid_item","detail":"item must be a string"},
+			{"index":3,"code":"invalid_item","detail":"item must be a string"},
+			{"index":4,"code":"item_too_long","detail":"item is longer than 4 bytes"}
+		],
+		"meta": {"request_id":"test-request","count":2}
+	}`, w.Body.String())
+
+	// без ошибок errors не выводится
+	w = call(h.SendV2, http.MethodPost, "/v2/send", "application/json", `[]`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"data":[],"meta":{"request_id":"test-request","count":0}}`, w.Body.String())
+}
+
+func TestSendV2_Problems(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   string
+		hasher error
+		store  error
+		want   mw.Problem
+	}{
+		{name: "not an array", body: `{"a":1}`,
+			want: mw.Problem{Status: 400, Code: api.CodeBadRequest, Detail: "body must be a JSON array of strings"}},
+		{name: "no valid items", body: `[1]`,
+			want: mw.Problem{Status: 422, Code: api.CodeInvalidItem, Detail: "no valid items in request",
+				Errors: []mw.ItemError{{Index: 0, Code: api.CodeInvalidItem, Detail: "item must be a string"}}}},
+		{name: "service1 failed", body: `["a"]`, hasher: errors.New("boom"),
+			want: mw.Problem{Status: 500, Code: api.CodeInternal, Detail: "failed to hash and store items"}},
+		{name: "service1 unavailable", body: `["a"]`, hasher: &grpcclient.BreakerOpenError{RetryAfter: 2 * time.Second},
+			want: mw.Problem{Status: 503, Code: api.CodeUnavailable, Detail: "hashing service is unavailable, retry later"}},
+		{name: "tenant quota", body: `["a"]`, store: storage.ErrQuotaExceeded,
+			want: mw.Problem{Status: 403, Code: api.CodeQuotaExceeded, Detail: "tenant storage quota exceeded"}},
+	}
+	for _, tc := range cases {
+		store := &memStore{}
+		if tc.store != nil {
+			store.failInsert, store.insertErr = 1, tc.store
+		}
+		h := newHandlers(store)
+		h.HashClient = &fakeHasher{err: tc.hasher}
+
+		w := call(h.SendV2, http.MethodPost, "/v2/send", "application/json", tc.body)
+		require.Equal(t, tc.want.Status, w.Code, tc.name)
+		require.Equal(t, mw.MimeProblem, w.Header().Get("Content-Type"), tc.name)
+		// type и title выводятся из кода и статуса, instance — путь запроса
+		tc.want.Type = "urn:hashing-system:problem:" + tc.want.Code
+		tc.want.Title = http.StatusText(tc.want.Status)
+		tc.want.Instance = "/v2/send"
+		tc.want.RequestID = testRequestID
+		require.Equal(t, tc.want, decode[mw.Problem](t, w), tc.name)
+	}
+}
+
+func TestCheckV2(t *testing.T) {
+	h := newHandlers(checkStore(t))
+
+	// индексы ошибок — позиции в исходном запросе, включая неразобранные ID
+	w := call(h.CheckV2, http.MethodGet, "/v2/check?ids=3,x,2&ids=9,1", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"data": [{"id":3,"hash":"h:c"},{"id":1,"hash":"h:a"}],
+		"errors": [
+			{"index":1,"code":"invalid_id","detail":"\"x\" is not an integer id"},
+			{"index":2,"code":"deleted"},
+			{"index":3,"code":"not_found"}
+		],
+		"meta": {"request_id":"test-request","count":2}
+	}`, w.Body.String())
+
+	w = call(h.CheckV2, http.MethodPost, "/v2/check", "application/json", `{"ids":[9,1],"mode":"status"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"data": [{"id":9,"status":"not_found"},{"id":1,"status":"found","hash":"h:a"}],
+		"meta": {"request_id":"test-request","count":2}
+	}`, w.Body.String())
+
+	w = call(h.CheckV2, http.MethodGet, "/v2/check?ids=1&mode=full", "", "")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	p := decode[mw.Problem](t, w)
+	require.Equal(t, api.CodeBadRequest, p.Code)
+	require.Equal(t, `unknown mode "full"`, p.Detail)
+	require.Equal(t, testRequestID, p.RequestID)
+}
+
+func TestDeleteAndListV2(t *testing.T) {
+	h := newHandlers(checkStore(t))
+
+	w := call(h.DeleteV2, http.MethodDelete, "/v2/hashes?ids=1,9", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"data":{"deleted":[1]},"meta":{"request_id":"test-request","count":1}}`, w.Body.String())
+	w = call(h.DeleteV2, http.MethodDelete, "/v2/hashes?ids=1", "", "")
+	require.JSONEq(t, `{"data":{"deleted":[]},"meta":{"request_id":"test-request","count":0}}`, w.Body.String())
+
+	w = call(h.DeleteV2, http.MethodDelete, "/v2/hashes?ids=x", "", "")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, api.CodeInvalidID, decode[mw.Problem](t, w).Code)
+
+	_, err := h.Store.InsertHashes(context.Background(), storage.DefaultTenant, []string{"h:d", "h:e"})
+	require.NoError(t, err)
+
+	// полная страница указывает курсор следующей, последняя — нет
+	w = call(h.ListV2, http.MethodGet, "/v2/hashes?limit=2", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"data": [
+			{"id":3,"hash":"h:c","created_at":"2026-01-01T00:00:00Z"},
+			{"id":4,"hash":"h:d","created_at":"2026-01-01T00:00:00Z"}
+		],
+		"meta": {"request_id":"test-request","count":2,"next_after_id":4}
+	}`, w.Body.String())
+	w = call(h.ListV2, http.MethodGet, "/v2/hashes?limit=2&after_id=4", "", "")
+	require.JSONEq(t, `{
+		"data": [{"id":5,"hash":"h:e","created_at":"2026-01-01T00:00:00Z"}],
+		"meta": {"request_id":"test-request","count":1}
+	}`, w.Body.String())
+}
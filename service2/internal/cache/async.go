@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var cacheWritesDroppedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "service2_cache_writes_dropped_total",
+	Help: "Cache entries not written because the background write queue was full.",
+})
+
+var cacheWriteQueue = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "service2_cache_write_queue",
+	Help: "Batches waiting in the background cache write queue.",
+})
+
+func init() {
+	prometheus.MustRegister(cacheWritesDroppedTotal, cacheWriteQueue)
+}
+
+// Async writes to a Cache in the background, so that requests do not wait
+// for cache writes. Writes are queued in batches; when the queue is full a
+// batch is dropped and counted, and the keys are read from the source of
+// truth on the next miss. Del goes through the same queue and waits for it,
+// so a delete is never overtaken by a write queued before it.
+type Async struct {
+	Cache
+	log *logrus.Logger
+
+	// Timeout — сколько ждать одной записи в фоне
+	Timeout time.Duration
+
+	queue    chan asyncOp
+	quit     chan struct{}
+	finished chan struct{}
+	stop     sync.Once
+}
+
+type asyncOp struct {
+	entries []Entry
+	ttl     time.Duration
+	add     bool // AddMany вместо SetMany
+	del     []string
+	done    chan error
+}
+
+// NewAsync starts a background writer to next with a queue of up to size
+// batches. Close stops it.
+func NewAsync(next Cache, size int, log *logrus.Logger) *Async {
+	a := &Async{
+		Cache:    next,
+		log:      log,
+		Timeout:  time.Second,
+		queue:    make(chan asyncOp, size),
+		quit:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+func (a *Async) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return a.SetMany(ctx, []Entry{{Key: key, Value: value}}, ttl)
+}
+
+// SetMany queues entries and returns at once. It never fails: a batch that
+// does not fit into the queue is dropped.
+func (a *Async) SetMany(_ context.Context, entries []Entry, ttl time.Duration) error {
+	a.enqueue(asyncOp{entries: entries, ttl: ttl})
+	return nil
+}
+
+// AddMany queues entries like SetMany.
+func (a *Async) AddMany(_ context.Context, entries []Entry, ttl time.Duration) error {
+	a.enqueue(asyncOp{entries: entries, ttl: ttl, add: true})
+	return nil
+}
+
+func (a *Async) enqueue(op asyncOp) {
+	select {
+	case <-a.quit:
+		return
+	default:
+	}
+	select {
+	case a.queue <- op:
+		cacheWriteQueue.Set(float64(len(a.queue)))
+	default:
+		cacheWritesDroppedTotal.Add(float64(len(op.entries)))
+	}
+}
+
+func (a *Async) Del(ctx context.Context, keys ...string) error {
+	op := asyncOp{del: keys, done: make(chan error, 1)}
+	select {
+	case a.queue <- op:
+	case <-a.finished:
+		return a.Cache.Del(ctx, keys...)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-op.done:
+		return err
+	case <-a.finished:
+		// писатель остановлен, не дойдя до удаления
+		return a.Cache.Del(ctx, keys...)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close writes out what is queued and stops the writer.
+func (a *Async) Close() {
+	a.stop.Do(func() { close(a.quit) })
+	<-a.finished
+}
+
+func (a *Async) run() {
+	defer close(a.finished)
+	for {
+		select {
+		case op := <-a.queue:
+			a.apply(op)
+		case <-a.quit:
+			for {
+				select {
+				case op := <-a.queue:
+					a.apply(op)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (a *Async) apply(op asyncOp) {
+	cacheWriteQueue.Set(float64(len(a.queue)))
+	ctx, cancel := context.WithTimeout(context.Background(), a.Timeout)
+	defer cancel()
+	if op.done != nil {
+		op.done <- a.Cache.Del(ctx, op.del...)
+		return
+	}
+	var err error
+	if op.add {
+		err = a.Cache.AddMany(ctx, op.entries, op.ttl)
+	} else {
+		err = a.Cache.SetMany(ctx, op.entries, op.ttl)
+	}
+	if err != nil && !errors.Is(err, ErrUnavailable) {
+		a.log.WithError(err).WithField("count", len(op.entries)).Warn("cache: background write failed")
+	}
+}
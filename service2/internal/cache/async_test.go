@@ -0,0 +1,55 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/cache"
+)
+
+// blockedCache reports SetMany calls on started and holds them until release
+// is closed.
+type blockedCache struct {
+	cache.Cache
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockedCache) SetMany(ctx context.Context, entries []cache.Entry, ttl time.Duration) error {
+	b.started <- struct{}{}
+	<-b.release
+	return b.Cache.SetMany(ctx, entries, ttl)
+}
+
+func TestAsync(t *testing.T) {
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	next := &blockedCache{Cache: cache.NewRedis(rdb), started: make(chan struct{}, 10), release: make(chan struct{})}
+	a := cache.NewAsync(next, 1, logrus.New())
+	ctx := context.Background()
+
+	// первая пачка занимает писателя, вторая ждёт в очереди, третья
+	// не помещается и отбрасывается
+	require.NoError(t, a.SetMany(ctx, []cache.Entry{{Key: "a", Value: "1"}}, time.Minute))
+	<-next.started
+	require.NoError(t, a.SetMany(ctx, []cache.Entry{{Key: "b", Value: "2"}}, time.Minute))
+	require.NoError(t, a.SetMany(ctx, []cache.Entry{{Key: "c", Value: "3"}, {Key: "d", Value: "4"}}, time.Minute))
+
+	// удаление выполняется после записей, поставленных до него
+	del := make(chan error, 1)
+	go func() { del <- a.Del(ctx, "b") }()
+	close(next.release)
+	require.NoError(t, <-del)
+	a.Close()
+
+	vals, err := next.Get(ctx, []string{"a", "b", "c", "d"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "", "", ""}, vals)
+}
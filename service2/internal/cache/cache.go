@@ -0,0 +1,131 @@
+// Package cache keeps recently stored and read hashes close to the API, so
+// that /check does not go to Postgres for every ID.
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Cache stores string values by key. It is an optimisation only: callers
+// treat every error as a miss and read the source of truth instead.
+type Cache interface {
+	// Get returns the values of keys in order; a missing key gives "".
+	Get(ctx context.Context, keys []string) ([]string, error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+	// SetMany stores entries for ttl in one round-trip.
+	SetMany(ctx context.Context, entries []Entry, ttl time.Duration) error
+	// AddMany stores the entries whose keys are neither set nor deleted
+	// within TombstoneTTL. It is meant for values read from the source of
+	// truth, which may be stale by the time they are cached.
+	AddMany(ctx context.Context, entries []Entry, ttl time.Duration) error
+	// Del removes keys and keeps AddMany from storing them for TombstoneTTL.
+	Del(ctx context.Context, keys ...string) error
+}
+
+// TombstoneTTL is how long a deleted key cannot be filled again by AddMany.
+// It outlasts any request that read the value before it was deleted.
+const TombstoneTTL = time.Minute
+
+// tombstone marks a deleted key in Redis; Get reports it as a miss.
+const tombstone = "\x00deleted"
+
+// Entry is a key and its value.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// Redis is a Cache in Redis.
+type Redis struct {
+	Client *redis.Client
+}
+
+// NewRedis returns a Cache over rdb.
+func NewRedis(rdb *redis.Client) *Redis {
+	return &Redis{Client: rdb}
+}
+
+func (r *Redis) Get(ctx context.Context, keys []string) ([]string, error) {
+	vals, err := r.Client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, len(vals))
+	for i, v := range vals {
+		if s, _ := v.(string); s != tombstone {
+			out[i] = s
+		}
+	}
+	return out, nil
+}
+
+func (r *Redis) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return r.Client.Set(ctx, key, value, ttl).Err()
+}
+
+// SetMany pipelines one SET ... EX per entry.
+func (r *Redis) SetMany(ctx context.Context, entries []Entry, ttl time.Duration) error {
+	_, err := r.Client.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, e := range entries {
+			p.Set(ctx, e.Key, e.Value, ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+// AddMany pipelines one SET ... NX EX per entry.
+func (r *Redis) AddMany(ctx context.Context, entries []Entry, ttl time.Duration) error {
+	_, err := r.Client.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, e := range entries {
+			p.SetNX(ctx, e.Key, e.Value, ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+// Del replaces keys with tombstones, so that a value read before the delete
+// is not cached again by AddMany.
+func (r *Redis) Del(ctx context.Context, keys ...string) error {
+	_, err := r.Client.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, k := range keys {
+			p.Set(ctx, k, tombstone, TombstoneTTL)
+		}
+		return nil
+	})
+	return err
+}
+
+// DelPrefix deletes every key that starts with prefix. It scans the keyspace
+// in batches instead of blocking Redis with KEYS.
+func (r *Redis) DelPrefix(ctx context.Context, prefix string) error {
+	const batch = 1000
+	iter := r.Client.Scan(ctx, 0, prefix+"*", batch).Iterator()
+	keys := make([]string, 0, batch)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == batch {
+			if err := r.Client.Unlink(ctx, keys...).Err(); err != nil {
+				return err
+			}
+			keys = keys[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.Client.Unlink(ctx, keys...).Err()
+}
+
+// Ping checks the connection to Redis.
+func (r *Redis) Ping(ctx context.Context) error {
+	return r.Client.Ping(ctx).Err()
+}
@@ -0,0 +1,109 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/cache"
+)
+
+func TestRedis(t *testing.T) {
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	c := cache.NewRedis(rdb)
+	ctx := context.Background()
+
+	require.NoError(t, c.Set(ctx, "a", "1", time.Minute))
+	vals, err := c.Get(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", ""}, vals)
+
+	require.NoError(t, c.Del(ctx, "a"))
+	vals, err = c.Get(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{""}, vals)
+
+	require.NoError(t, c.SetMany(ctx, []cache.Entry{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}, time.Minute))
+	vals, err = c.Get(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, vals)
+	require.Equal(t, time.Minute, srv.TTL("b"))
+
+	// AddMany не перезаписывает ни значения, ни недавно удалённые ключи
+	require.NoError(t, c.Del(ctx, "a"))
+	require.NoError(t, c.AddMany(ctx, []cache.Entry{{Key: "a", Value: "old"}, {Key: "b", Value: "old"}, {Key: "c", Value: "3"}}, time.Minute))
+	vals, err = c.Get(ctx, []string{"a", "b", "c"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"", "2", "3"}, vals)
+
+	srv.FastForward(cache.TombstoneTTL)
+	require.NoError(t, c.AddMany(ctx, []cache.Entry{{Key: "a", Value: "1"}}, time.Minute))
+	vals, err = c.Get(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, vals)
+}
+
+func TestGuarded(t *testing.T) {
+	// Redis запускается и сразу останавливается, чтобы позже подняться на
+	// том же адресе
+	srv := miniredis.NewMiniRedis()
+	require.NoError(t, srv.Start())
+	addr := srv.Addr()
+	srv.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: addr, MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	t.Cleanup(func() { _ = rdb.Close() })
+	r := cache.NewRedis(rdb)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Redis недоступен при старте: кэш выключен и не вызывается
+	g := cache.NewGuarded(ctx, r, r.Ping, logrus.New())
+	g.Retry = 20 * time.Millisecond
+	g.Purge = func(ctx context.Context) error { return r.DelPrefix(ctx, "hash:") }
+	require.False(t, g.Healthy())
+	_, err := g.Get(ctx, []string{"a"})
+	require.ErrorIs(t, err, cache.ErrUnavailable)
+
+	go g.Run(ctx)
+	require.NoError(t, srv.StartAddr(addr))
+	defer srv.Close()
+	require.Eventually(t, g.Healthy, 2*time.Second, 10*time.Millisecond)
+	require.NoError(t, g.Set(ctx, "a", "1", time.Minute))
+	vals, err := g.Get(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, vals)
+
+	// после Failures ошибок подряд кэш выключается
+	srv.Close()
+	for i := 0; i < g.Failures; i++ {
+		_, err := g.Get(ctx, []string{"a"})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, cache.ErrUnavailable)
+	}
+	require.False(t, g.Healthy())
+	require.ErrorIs(t, g.Del(ctx, "a"), cache.ErrUnavailable)
+
+	// пропущенное удаление очищает кэш перед тем, как он снова включится
+	srv.Set("hash:a", "1")
+	srv.Set("other", "2")
+	require.NoError(t, srv.StartAddr(addr))
+	require.Eventually(t, g.Healthy, 2*time.Second, 10*time.Millisecond)
+	require.False(t, srv.Exists("hash:a"))
+	require.True(t, srv.Exists("other"))
+
+	// неудачное удаление выключает кэш до очистки, даже если он ещё в работе
+	srv.SetError("boom")
+	require.Error(t, g.Del(ctx, "a"))
+	require.False(t, g.Healthy())
+	srv.Set("hash:b", "1")
+	srv.SetError("")
+	require.Eventually(t, g.Healthy, 2*time.Second, 10*time.Millisecond)
+	require.False(t, srv.Exists("hash:b"))
+}
@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var cacheUp = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "service2_cache_up",
+	Help: "Whether the cache backend is in use (1) or skipped as unavailable (0).",
+})
+
+var cacheErrorsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "service2_cache_errors_total",
+		Help: "Cache calls that failed, by operation.",
+	},
+	[]string{"op"},
+)
+
+var cacheSkippedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "service2_cache_skipped_total",
+		Help: "Cache calls not made because the backend is unavailable, by operation.",
+	},
+	[]string{"op"},
+)
+
+func init() {
+	prometheus.MustRegister(cacheUp, cacheErrorsTotal, cacheSkippedTotal)
+}
+
+// ErrUnavailable is returned by Guarded instead of calling a backend that is
+// down.
+var ErrUnavailable = errors.New("cache is unavailable")
+
+// Guarded is a circuit breaker around a Cache. After Failures calls in a row
+// fail it marks the backend down and answers every call with ErrUnavailable
+// without touching it, so that requests do not wait for a dead Redis. Run
+// pings the backend in the background and puts it back in use once it
+// answers.
+//
+// A Del that was skipped or failed leaves a deleted value in the backend.
+// With Purge set, a failed Del marks the backend down too, and Purge clears
+// it before it is used again; if Purge fails, the backend stays down.
+type Guarded struct {
+	next Cache
+	ping func(ctx context.Context) error
+	log  *logrus.Logger
+
+	// Failures — сколько ошибок подряд выключают кэш
+	Failures int
+	// Retry — как часто проверять выключенный кэш
+	Retry time.Duration
+	// Purge, если задан, удаляет из бэкенда все значения, которые могли
+	// устареть
+	Purge func(ctx context.Context) error
+
+	up     atomic.Bool
+	failed atomic.Int32
+	// stale — удаление не дошло до бэкенда, в нём может остаться удалённое
+	stale atomic.Bool
+}
+
+// NewGuarded wraps next. It pings the backend once, so that a cache that is
+// down at startup starts out degraded instead of failing the first calls.
+func NewGuarded(ctx context.Context, next Cache, ping func(ctx context.Context) error, log *logrus.Logger) *Guarded {
+	g := &Guarded{next: next, ping: ping, log: log, Failures: 3, Retry: 5 * time.Second}
+	pctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if err := ping(pctx); err != nil {
+		log.WithError(err).Warn("cache: backend is unavailable, starting without cache")
+		cacheUp.Set(0)
+	} else {
+		g.up.Store(true)
+		cacheUp.Set(1)
+	}
+	return g
+}
+
+// Healthy reports whether the backend is in use.
+func (g *Guarded) Healthy() bool {
+	return g.up.Load()
+}
+
+// Run pings the backend every Retry while it is down until ctx is done.
+func (g *Guarded) Run(ctx context.Context) {
+	t := time.NewTicker(g.Retry)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		if g.up.Load() {
+			continue
+		}
+		pctx, cancel := context.WithTimeout(ctx, g.Retry)
+		err := g.ping(pctx)
+		cancel()
+		if err != nil || !g.purge(ctx) {
+			continue
+		}
+		g.failed.Store(0)
+		g.up.Store(true)
+		cacheUp.Set(1)
+		g.log.Info("cache: backend is back")
+		// удаление, пропущенное во время очистки, ещё не учтено
+		g.purge(ctx)
+	}
+}
+
+// purge clears the backend if a Del has not reached it and reports whether
+// the backend can be trusted.
+func (g *Guarded) purge(ctx context.Context) bool {
+	if g.Purge == nil || !g.stale.Swap(false) {
+		return true
+	}
+	pctx, cancel := context.WithTimeout(ctx, g.Retry)
+	defer cancel()
+	if err := g.Purge(pctx); err != nil {
+		g.stale.Store(true)
+		g.log.WithError(err).Warn("cache: purging stale values failed")
+		return false
+	}
+	g.log.Info("cache: purged values that may have been deleted while it was down")
+	return true
+}
+
+func (g *Guarded) Get(ctx context.Context, keys []string) ([]string, error) {
+	var out []string
+	err := g.do(ctx, "get", func() (err error) {
+		out, err = g.next.Get(ctx, keys)
+		return err
+	})
+	return out, err
+}
+
+func (g *Guarded) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return g.do(ctx, "set", func() error { return g.next.Set(ctx, key, value, ttl) })
+}
+
+func (g *Guarded) SetMany(ctx context.Context, entries []Entry, ttl time.Duration) error {
+	return g.do(ctx, "set", func() error { return g.next.SetMany(ctx, entries, ttl) })
+}
+
+func (g *Guarded) AddMany(ctx context.Context, entries []Entry, ttl time.Duration) error {
+	return g.do(ctx, "set", func() error { return g.next.AddMany(ctx, entries, ttl) })
+}
+
+func (g *Guarded) Del(ctx context.Context, keys ...string) error {
+	err := g.do(ctx, "del", func() error { return g.next.Del(ctx, keys...) })
+	if err == nil {
+		return nil
+	}
+	g.stale.Store(true)
+	// значение могло остаться в бэкенде: до очистки он не используется
+	if g.Purge != nil && g.up.CompareAndSwap(true, false) {
+		cacheUp.Set(0)
+		g.log.WithError(err).Warn("cache: delete failed, continuing without cache until it is purged")
+	}
+	return err
+}
+
+func (g *Guarded) do(ctx context.Context, op string, call func() error) error {
+	if !g.up.Load() {
+		cacheSkippedTotal.WithLabelValues(op).Inc()
+		return ErrUnavailable
+	}
+	err := call()
+	if err == nil {
+		g.failed.Store(0)
+		return nil
+	}
+	if ctx.Err() != nil {
+		// запрос отменён клиентом, о кэше это ничего не говорит
+		return err
+	}
+	cacheErrorsTotal.WithLabelValues(op).Inc()
+	if int(g.failed.Add(1)) >= g.Failures && g.up.CompareAndSwap(true, false) {
+		cacheUp.Set(0)
+		g.log.WithError(err).Warn("cache: backend failed, continuing without cache")
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// lru is a size-bounded map that evicts the least recently used entry and
+// drops entries past their expiry on access. entries, if not nil, tracks its
+// length.
+type lru struct {
+	size    int
+	entries prometheus.Gauge
+
+	mu    sync.Mutex
+	items map[string]*list.Element
+	order *list.List // от недавних к давним
+	// gen растёт при каждом удалении, см. setIfGen
+	gen uint64
+}
+
+type lruEntry struct {
+	key     string
+	value   string
+	expires time.Time
+}
+
+func newLRU(size int, entries prometheus.Gauge) *lru {
+	return &lru{size: size, entries: entries, items: make(map[string]*list.Element), order: list.New()}
+}
+
+func (l *lru) get(key string, now time.Time) (string, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	el, ok := l.items[key]
+	if !ok {
+		return "", false
+	}
+	e := el.Value.(*lruEntry)
+	if !now.Before(e.expires) {
+		l.remove(el)
+		l.track()
+		return "", false
+	}
+	l.order.MoveToFront(el)
+	return e.value, true
+}
+
+func (l *lru) set(key, value string, expires time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.put(key, value, expires)
+}
+
+// generation returns the current deletion generation.
+func (l *lru) generation() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.gen
+}
+
+// setIfGen sets key unless anything was deleted since generation gen was
+// read, so that a value read before a concurrent delete is not put back.
+func (l *lru) setIfGen(key, value string, expires time.Time, gen uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.gen == gen {
+		l.put(key, value, expires)
+	}
+}
+
+func (l *lru) put(key, value string, expires time.Time) {
+	if el, ok := l.items[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.value, e.expires = value, expires
+		l.order.MoveToFront(el)
+		return
+	}
+	l.items[key] = l.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	if l.order.Len() > l.size {
+		l.remove(l.order.Back())
+	}
+	l.track()
+}
+
+func (l *lru) del(keys ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.gen++
+	for _, k := range keys {
+		if el, ok := l.items[k]; ok {
+			l.remove(el)
+		}
+	}
+	l.track()
+}
+
+func (l *lru) purge() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.gen++
+	clear(l.items)
+	l.order.Init()
+	l.track()
+}
+
+func (l *lru) track() {
+	if l.entries != nil {
+		l.entries.Set(float64(l.order.Len()))
+	}
+}
+
+func (l *lru) remove(el *list.Element) {
+	l.order.Remove(el)
+	delete(l.items, el.Value.(*lruEntry).key)
+}
@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+)
+
+var cacheLookupsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "service2_cache_lookups_total",
+		Help: "Keys looked up in the cache, by tier (local, remote) and result (hit, miss).",
+	},
+	[]string{"tier", "result"},
+)
+
+var cacheLocalEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "service2_cache_local_entries",
+	Help: "Entries in the in-process cache tier.",
+})
+
+func init() {
+	prometheus.MustRegister(cacheLookupsTotal, cacheLocalEntries)
+}
+
+// InvalidationChannel is the Redis pub/sub channel on which Tiered caches
+// announce deleted keys, one per line.
+const InvalidationChannel = "cache:invalidate"
+
+// Tiered keeps recently used entries in process memory in front of a remote
+// Cache, so that hot keys are served without a network round-trip.
+// Concurrent misses of the same keys share one remote lookup.
+//
+// Values of a key never change, they are only deleted. Del therefore
+// announces deleted keys to every instance through Redis pub/sub, and local
+// entries expire after a short TTL in case an announcement is lost. Values
+// read from the remote while a delete was applied are returned but not kept
+// locally.
+type Tiered struct {
+	remote Cache
+	rdb    *redis.Client // nil — без рассылки инвалидаций
+	log    *logrus.Logger
+
+	local    *lru
+	localTTL time.Duration
+	flight   singleflight.Group
+}
+
+// NewTiered puts an in-process tier of up to size entries, each kept for at
+// most ttl, in front of remote. rdb, if not nil, carries invalidations
+// between instances; Run must be running to receive them.
+func NewTiered(remote Cache, rdb *redis.Client, size int, ttl time.Duration, log *logrus.Logger) *Tiered {
+	return &Tiered{remote: remote, rdb: rdb, log: log, local: newLRU(size, cacheLocalEntries), localTTL: ttl}
+}
+
+func (t *Tiered) Get(ctx context.Context, keys []string) ([]string, error) {
+	now := time.Now()
+	out := make([]string, len(keys))
+	var missIdx []int
+	var miss []string
+	for i, k := range keys {
+		if v, ok := t.local.get(k, now); ok {
+			out[i] = v
+			continue
+		}
+		missIdx = append(missIdx, i)
+		miss = append(miss, k)
+	}
+	cacheLookupsTotal.WithLabelValues("local", "hit").Add(float64(len(keys) - len(miss)))
+	cacheLookupsTotal.WithLabelValues("local", "miss").Add(float64(len(miss)))
+	if len(miss) == 0 {
+		return out, nil
+	}
+
+	// одинаковые промахи, пришедшие одновременно, идут в Redis одним запросом;
+	// результат не зависит от контекста первого из них. Поколение удалений
+	// берётся до запроса: присоединившиеся позже получают тот же ответ
+	flightCtx := context.WithoutCancel(ctx)
+	res, err, _ := t.flight.Do(strings.Join(miss, "\n"), func() (any, error) {
+		gen := t.local.generation()
+		vals, err := t.remote.Get(flightCtx, miss)
+		return remoteGet{vals: vals, gen: gen}, err
+	})
+	if err != nil {
+		if len(miss) == len(keys) {
+			return nil, err
+		}
+		// часть ключей уже найдена локально, остальные — промахи
+		return out, nil
+	}
+
+	rg := res.(remoteGet)
+	expires := now.Add(t.localTTL)
+	hits := 0
+	for j, v := range rg.vals {
+		if v == "" {
+			continue
+		}
+		hits++
+		out[missIdx[j]] = v
+		// ключ мог быть удалён, пока шёл запрос: такой ответ не запоминаем
+		t.local.setIfGen(miss[j], v, expires, rg.gen)
+	}
+	cacheLookupsTotal.WithLabelValues("remote", "hit").Add(float64(hits))
+	cacheLookupsTotal.WithLabelValues("remote", "miss").Add(float64(len(miss) - hits))
+	return out, nil
+}
+
+// remoteGet is a remote lookup with the deletion generation of the local
+// tier taken before it started.
+type remoteGet struct {
+	vals []string
+	gen  uint64
+}
+
+func (t *Tiered) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	t.local.set(key, value, t.expires(ttl))
+	return t.remote.Set(ctx, key, value, ttl)
+}
+
+func (t *Tiered) SetMany(ctx context.Context, entries []Entry, ttl time.Duration) error {
+	expires := t.expires(ttl)
+	for _, e := range entries {
+		t.local.set(e.Key, e.Value, expires)
+	}
+	return t.remote.SetMany(ctx, entries, ttl)
+}
+
+// AddMany goes to the remote tier only: whether an entry is stored there
+// depends on tombstones only the remote knows about, and the local tier picks
+// up what was stored on the next Get.
+func (t *Tiered) AddMany(ctx context.Context, entries []Entry, ttl time.Duration) error {
+	return t.remote.AddMany(ctx, entries, ttl)
+}
+
+// expires returns when an entry stored for ttl leaves the local tier.
+func (t *Tiered) expires(ttl time.Duration) time.Time {
+	local := t.localTTL
+	if ttl > 0 {
+		local = min(ttl, local)
+	}
+	return time.Now().Add(local)
+}
+
+func (t *Tiered) Del(ctx context.Context, keys ...string) error {
+	t.local.del(keys...)
+	err := t.remote.Del(ctx, keys...)
+	if t.rdb != nil && !errors.Is(err, ErrUnavailable) {
+		if perr := t.rdb.Publish(ctx, InvalidationChannel, strings.Join(keys, "\n")).Err(); perr != nil {
+			t.log.WithError(perr).Warn("cache: invalidation was not published")
+		}
+	}
+	return err
+}
+
+// Run applies invalidations published by other instances until ctx is done.
+// The local tier is cleared on every (re)subscription, since announcements
+// sent while the subscription was down are lost.
+func (t *Tiered) Run(ctx context.Context) {
+	if t.rdb == nil {
+		return
+	}
+	ps := t.rdb.Subscribe(ctx, InvalidationChannel)
+	defer ps.Close()
+	for {
+		msg, err := ps.Receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			// go-redis переподключится при следующем Receive
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		switch m := msg.(type) {
+		case *redis.Subscription:
+			if m.Kind == "subscribe" {
+				t.local.purge()
+			}
+		case *redis.Message:
+			t.local.del(strings.Split(m.Payload, "\n")...)
+		}
+	}
+}
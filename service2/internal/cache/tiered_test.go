@@ -0,0 +1,182 @@
+package cache_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/cache"
+)
+
+func newTiered(t *testing.T, srv *miniredis.Miniredis, size int) *cache.Tiered {
+	t.Helper()
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return cache.NewTiered(cache.NewRedis(rdb), rdb, size, time.Minute, logrus.New())
+}
+
+func TestTiered_LocalHit(t *testing.T) {
+	srv := miniredis.RunT(t)
+	c := newTiered(t, srv, 10)
+	ctx := context.Background()
+
+	require.NoError(t, c.Set(ctx, "a", "1", time.Minute))
+	require.True(t, srv.Exists("a"))
+
+	// локальный уровень отвечает, не обращаясь к Redis
+	srv.FlushAll()
+	vals, err := c.Get(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", ""}, vals)
+
+	// найденное в Redis запоминается локально
+	require.NoError(t, srv.Set("b", "2"))
+	vals, err = c.Get(ctx, []string{"b"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, vals)
+	srv.FlushAll()
+	vals, err = c.Get(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, vals)
+}
+
+func TestTiered_Evicts(t *testing.T) {
+	srv := miniredis.RunT(t)
+	c := newTiered(t, srv, 2)
+	ctx := context.Background()
+
+	require.NoError(t, c.Set(ctx, "a", "1", time.Minute))
+	require.NoError(t, c.Set(ctx, "b", "2", time.Minute))
+	_, err := c.Get(ctx, []string{"a"}) // b становится самым давним
+	require.NoError(t, err)
+	require.NoError(t, c.Set(ctx, "c", "3", time.Minute))
+
+	srv.FlushAll()
+	vals, err := c.Get(ctx, []string{"a", "b", "c"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "", "3"}, vals)
+}
+
+func TestTiered_Expires(t *testing.T) {
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	c := cache.NewTiered(cache.NewRedis(rdb), nil, 10, 20*time.Millisecond, logrus.New())
+	ctx := context.Background()
+
+	require.NoError(t, c.Set(ctx, "a", "1", time.Minute))
+	srv.FlushAll()
+	time.Sleep(30 * time.Millisecond)
+	vals, err := c.Get(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{""}, vals)
+}
+
+func TestTiered_Invalidation(t *testing.T) {
+	srv := miniredis.RunT(t)
+	a := newTiered(t, srv, 10)
+	b := newTiered(t, srv, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go b.Run(ctx)
+	require.Eventually(t, func() bool {
+		return srv.PubSubNumSub(cache.InvalidationChannel)[cache.InvalidationChannel] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, a.Set(ctx, "a", "1", time.Minute))
+	vals, err := b.Get(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, vals)
+
+	// удаление на одном экземпляре убирает ключ из памяти всех остальных
+	require.NoError(t, a.Del(ctx, "a"))
+	require.Eventually(t, func() bool {
+		vals, err := b.Get(ctx, []string{"a"})
+		return err == nil && vals[0] == ""
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+// slowCache counts Get calls and holds them until release is closed.
+type slowCache struct {
+	cache.Cache
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (s *slowCache) Get(ctx context.Context, keys []string) ([]string, error) {
+	s.calls.Add(1)
+	<-s.release
+	return s.Cache.Get(ctx, keys)
+}
+
+func TestTiered_CollapsesMisses(t *testing.T) {
+	srv := miniredis.RunT(t)
+	require.NoError(t, srv.Set("a", "1"))
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	remote := &slowCache{Cache: cache.NewRedis(rdb), release: make(chan struct{})}
+	c := cache.NewTiered(remote, nil, 10, time.Minute, logrus.New())
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vals, err := c.Get(context.Background(), []string{"a"})
+			require.NoError(t, err)
+			require.Equal(t, []string{"1"}, vals)
+		}()
+	}
+	require.Eventually(t, func() bool { return remote.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(remote.release)
+	wg.Wait()
+	require.EqualValues(t, 1, remote.calls.Load())
+}
+
+// staleCache reads keys at once but answers only when release is closed, like
+// a reply still in flight while the keys are deleted.
+type staleCache struct {
+	cache.Cache
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (s *staleCache) Get(ctx context.Context, keys []string) ([]string, error) {
+	vals, err := s.Cache.Get(ctx, keys)
+	s.calls.Add(1)
+	<-s.release
+	return vals, err
+}
+
+func TestTiered_GetDuringDel(t *testing.T) {
+	srv := miniredis.RunT(t)
+	require.NoError(t, srv.Set("a", "1"))
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	remote := &staleCache{Cache: cache.NewRedis(rdb), release: make(chan struct{})}
+	c := cache.NewTiered(remote, nil, 10, time.Minute, logrus.New())
+	ctx := context.Background()
+
+	done := make(chan []string)
+	go func() {
+		vals, _ := c.Get(ctx, []string{"a"})
+		done <- vals
+	}()
+	require.Eventually(t, func() bool { return remote.calls.Load() == 1 }, time.Second, time.Millisecond)
+	require.NoError(t, c.Del(ctx, "a"))
+	close(remote.release)
+	require.Equal(t, []string{"1"}, <-done)
+
+	// прочитанное до удаления не возвращается в локальный уровень
+	vals, err := c.Get(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{""}, vals)
+}
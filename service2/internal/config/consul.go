@@ -2,10 +2,13 @@ package config
 
 import (
 	"context"
+	"math"
+	"strconv"
 	"time"
 
 	consulapi "github.com/hashicorp/consul/api"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 type AppConfig struct {
@@ -14,6 +17,99 @@ type AppConfig struct {
 	HTTPPort   string
 	RedisAddr  string
 	CacheTTL   time.Duration
+
+	// LogLevel — уровень логов: debug, info (по умолчанию), warn или error
+	LogLevel string
+
+	// CacheLocalSize — сколько записей держать в памяти процесса перед Redis,
+	// 0 — без локального уровня; CacheLocalTTL — сколько хранить каждую
+	CacheLocalSize int
+	CacheLocalTTL  time.Duration
+	// CacheWriteQueue — сколько пачек записей в кэш может ждать фоновой
+	// записи, 0 — запись в кэш в самом запросе
+	CacheWriteQueue int
+
+	// MemoTTL включает запоминание хешей по входной строке на этот срок,
+	// 0 — каждая строка хешируется в service1; MemoKey — ключ HMAC отпечатков,
+	// общий для всех экземпляров; строки длиннее MemoMaxBytes не запоминаются
+	MemoTTL      time.Duration
+	MemoKey      string
+	MemoMaxBytes int
+	// HashAlgorithm — алгоритм service1 из config/service1/algorithm; им
+	// запоминание пользуется, пока service1 не сообщит свой
+	HashAlgorithm string
+
+	// GRPCDiscovery — как искать service1: static — по адресу
+	// service1:HasherPort, consul — все здоровые экземпляры из каталога Consul
+	// с балансировкой round_robin
+	GRPCDiscovery string
+
+	// GRPCTLSCA и GRPCTLSCert включают TLS к service1: CA для проверки
+	// сервера, сертификат с ключом — для mTLS
+	GRPCTLSCA   string
+	GRPCTLSCert string
+	GRPCTLSKey  string
+	// GRPCTLSServerName — имя в сертификате service1, если отличается от адреса
+	GRPCTLSServerName string
+
+	// учётные данные service2 при вызовах service1: с GRPCHMACSecret вызовы
+	// подписываются, иначе передаётся GRPCToken
+	GRPCClientID   string
+	GRPCToken      string
+	GRPCHMACSecret string
+
+	// GRPCTimeout — дедлайн одной попытки вызова service1, 0 — только дедлайн
+	// HTTP-запроса
+	GRPCTimeout time.Duration
+	// повтор вызовов service1 при Unavailable и ResourceExhausted: число
+	// попыток вместе с первой и границы паузы между ними
+	GRPCRetryAttempts   int
+	GRPCRetryBackoff    time.Duration
+	GRPCRetryMaxBackoff time.Duration
+	// GRPCBreakerFailures — сколько вызовов подряд должно упасть, чтобы
+	// брейкер открылся на GRPCBreakerCooldown; 0 — без брейкера
+	GRPCBreakerFailures int
+	GRPCBreakerCooldown time.Duration
+
+	// DBRLS дополнительно ограничивает запросы тенантом через RLS Postgres,
+	// по умолчанию выключено; с ним роль БД не должна обходить RLS
+	DBRLS bool
+
+	// AuthEnabled включает обязательную аутентификацию; без неё все запросы
+	// выполняются от имени тенанта по умолчанию с правами hash:read и
+	// hash:write, а маршруты admin не подключаются
+	AuthEnabled bool
+	// AdminKey — начальный API-ключ со scope admin для выдачи остальных ключей
+	AdminKey string
+
+	// RateLimitRPS и RateLimitBurst — token bucket запросов на клиента,
+	// 0 — без ограничения
+	RateLimitRPS   float64
+	RateLimitBurst int
+	// DailyStringQuota — сколько строк клиент может хешировать за сутки (UTC)
+	DailyStringQuota int
+
+	// ограничения размера запроса, 0 — без ограничения: тело JSON в байтах,
+	// тело загрузки файлов и импорта CSV в байтах, число строк или ID в
+	// запросе и длина одной строки в байтах
+	MaxBodyBytes   int64
+	MaxUploadBytes int64
+	MaxItems       int
+	MaxItemBytes   int
+	// GRPCMaxItems — сколько строк service1 принимает за вызов, из
+	// config/service1/max_items; 0 — без ограничения
+	GRPCMaxItems int
+
+	// ShutdownDelay — сколько /readyz отвечает 503 перед остановкой
+	// HTTP-сервера, чтобы балансировщики успели снять экземпляр
+	ShutdownDelay time.Duration
+
+	// OIDCJWKS — путь к файлу или URL с JWKS провайдера; пусто — JWT не принимаются
+	OIDCJWKS     string
+	OIDCIssuer   string
+	OIDCAudience string
+	// OIDCTenantClaim — claim токена с тенантом, по умолчанию tenant_id
+	OIDCTenantClaim string
 }
 
 func Load(ctx context.Context, consulAddr string) (*AppConfig, error) {
@@ -46,14 +142,136 @@ func Load(ctx context.Context, consulAddr string) (*AppConfig, error) {
 	}
 
 	cfg.HTTPPort = getKV("config/service2/http_port", cfg.HTTPPort)
+	cfg.LogLevel = getKV("config/service2/log_level", "info")
+	if _, err := logrus.ParseLevel(cfg.LogLevel); err != nil {
+		return nil, errors.Wrap(err, "log_level")
+	}
 	cfg.DBDSN = getKV("config/service2/db_dsn", cfg.DBDSN)
 	cfg.HasherPort = getKV("config/grpc_port", cfg.HasherPort)
+	cfg.GRPCDiscovery = getKV("config/service2/grpc_discovery", "static")
+	if cfg.GRPCDiscovery != "static" && cfg.GRPCDiscovery != "consul" {
+		return nil, errors.Errorf("grpc_discovery must be static or consul, got %q", cfg.GRPCDiscovery)
+	}
 	cfg.RedisAddr = getKV("config/service2/redis_addr", cfg.RedisAddr)
 	if ttlStr := getKV("config/service2/redis_ttl", ""); ttlStr != "" {
 		if d, err := time.ParseDuration(ttlStr); err == nil {
 			cfg.CacheTTL = d
 		}
 	}
+	cfg.CacheLocalSize = 100000
+	if v, err := strconv.Atoi(getKV("config/service2/cache_local_size", "")); err == nil && v >= 0 {
+		cfg.CacheLocalSize = v
+	}
+	cfg.CacheLocalTTL = time.Minute
+	if d, err := time.ParseDuration(getKV("config/service2/cache_local_ttl", "")); err == nil && d > 0 {
+		cfg.CacheLocalTTL = d
+	}
+	if v, err := strconv.Atoi(getKV("config/service2/cache_write_queue", "")); err == nil && v > 0 {
+		cfg.CacheWriteQueue = v
+	}
+
+	if d, err := time.ParseDuration(getKV("config/service2/memo_ttl", "")); err == nil && d > 0 {
+		cfg.MemoTTL = d
+	}
+	cfg.MemoKey = getKV("config/service2/memo_key", cfg.MemoKey)
+	if cfg.MemoTTL > 0 && cfg.MemoKey == "" {
+		return nil, errors.New("memo_key must be set when memo_ttl is")
+	}
+	cfg.MemoMaxBytes = 1024
+	if v, err := strconv.Atoi(getKV("config/service2/memo_max_bytes", "")); err == nil && v >= 0 {
+		cfg.MemoMaxBytes = v
+	}
+	cfg.HashAlgorithm = getKV("config/service1/algorithm", "sha3-256")
+
+	cfg.GRPCTLSCA = getKV("config/service2/grpc_tls_ca", cfg.GRPCTLSCA)
+	cfg.GRPCTLSCert = getKV("config/service2/grpc_tls_cert", cfg.GRPCTLSCert)
+	cfg.GRPCTLSKey = getKV("config/service2/grpc_tls_key", cfg.GRPCTLSKey)
+	cfg.GRPCTLSServerName = getKV("config/service2/grpc_tls_server_name", cfg.GRPCTLSServerName)
+	cfg.GRPCClientID = getKV("config/service2/grpc_client_id", "service2")
+	cfg.GRPCToken = getKV("config/service2/grpc_token", cfg.GRPCToken)
+	cfg.GRPCHMACSecret = getKV("config/service2/grpc_hmac_secret", cfg.GRPCHMACSecret)
+
+	cfg.GRPCTimeout = 5 * time.Second
+	if d, err := time.ParseDuration(getKV("config/service2/grpc_timeout", "")); err == nil && d >= 0 {
+		cfg.GRPCTimeout = d
+	}
+	cfg.GRPCRetryAttempts = 3
+	if v, err := strconv.Atoi(getKV("config/service2/grpc_retry_attempts", "")); err == nil && v > 0 {
+		cfg.GRPCRetryAttempts = v
+	}
+	cfg.GRPCRetryBackoff = 100 * time.Millisecond
+	if d, err := time.ParseDuration(getKV("config/service2/grpc_retry_backoff", "")); err == nil && d >= 0 {
+		cfg.GRPCRetryBackoff = d
+	}
+	cfg.GRPCRetryMaxBackoff = time.Second
+	if d, err := time.ParseDuration(getKV("config/service2/grpc_retry_max_backoff", "")); err == nil && d >= 0 {
+		cfg.GRPCRetryMaxBackoff = d
+	}
+	cfg.GRPCBreakerFailures = 5
+	if v, err := strconv.Atoi(getKV("config/service2/grpc_breaker_failures", "")); err == nil && v >= 0 {
+		cfg.GRPCBreakerFailures = v
+	}
+	cfg.GRPCBreakerCooldown = 10 * time.Second
+	if d, err := time.ParseDuration(getKV("config/service2/grpc_breaker_cooldown", "")); err == nil && d > 0 {
+		cfg.GRPCBreakerCooldown = d
+	}
+
+	if (cfg.GRPCTLSCert == "") != (cfg.GRPCTLSKey == "") {
+		return nil, errors.New("grpc_tls_cert and grpc_tls_key must be set together")
+	}
+
+	if v, err := strconv.ParseBool(getKV("config/service2/db_rls", "")); err == nil {
+		cfg.DBRLS = v
+	}
+
+	if v, err := strconv.ParseBool(getKV("config/service2/auth_enabled", "")); err == nil {
+		cfg.AuthEnabled = v
+	}
+	cfg.AdminKey = getKV("config/service2/admin_key", cfg.AdminKey)
+
+	if v, err := strconv.ParseFloat(getKV("config/service2/rate_limit_rps", ""), 64); err == nil && v > 0 {
+		cfg.RateLimitRPS = v
+	}
+	if v, err := strconv.Atoi(getKV("config/service2/rate_limit_burst", "")); err == nil && v > 0 {
+		cfg.RateLimitBurst = v
+	}
+	if cfg.RateLimitRPS > 0 && cfg.RateLimitBurst == 0 {
+		cfg.RateLimitBurst = int(math.Ceil(cfg.RateLimitRPS))
+	}
+	if v, err := strconv.Atoi(getKV("config/service2/daily_string_quota", "")); err == nil && v > 0 {
+		cfg.DailyStringQuota = v
+	}
+
+	cfg.MaxBodyBytes = 4 << 20
+	if v, err := strconv.ParseInt(getKV("config/service2/max_body_bytes", ""), 10, 64); err == nil && v >= 0 {
+		cfg.MaxBodyBytes = v
+	}
+	cfg.MaxUploadBytes = 256 << 20
+	if v, err := strconv.ParseInt(getKV("config/service2/max_upload_bytes", ""), 10, 64); err == nil && v >= 0 {
+		cfg.MaxUploadBytes = v
+	}
+	cfg.MaxItems = 10000
+	if v, err := strconv.Atoi(getKV("config/service2/max_items", "")); err == nil && v >= 0 {
+		cfg.MaxItems = v
+	}
+	cfg.MaxItemBytes = 64 << 10
+	if v, err := strconv.Atoi(getKV("config/service2/max_item_bytes", "")); err == nil && v >= 0 {
+		cfg.MaxItemBytes = v
+	}
+	cfg.GRPCMaxItems = 10000
+	if v, err := strconv.Atoi(getKV("config/service1/max_items", "")); err == nil && v >= 0 {
+		cfg.GRPCMaxItems = v
+	}
+
+	cfg.ShutdownDelay = 5 * time.Second
+	if d, err := time.ParseDuration(getKV("config/service2/shutdown_delay", "")); err == nil && d >= 0 {
+		cfg.ShutdownDelay = d
+	}
+
+	cfg.OIDCJWKS = getKV("config/service2/oidc_jwks", cfg.OIDCJWKS)
+	cfg.OIDCIssuer = getKV("config/service2/oidc_issuer", cfg.OIDCIssuer)
+	cfg.OIDCAudience = getKV("config/service2/oidc_audience", cfg.OIDCAudience)
+	cfg.OIDCTenantClaim = getKV("config/service2/oidc_tenant_claim", cfg.OIDCTenantClaim)
 
 	return cfg, nil
 }
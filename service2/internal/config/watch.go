@@ -0,0 +1,115 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/sirupsen/logrus"
+)
+
+// Prefix is the Consul KV prefix of the service2 settings.
+const Prefix = "config/service2/"
+
+// secretFields are shown by Redacted only as set or not.
+var secretFields = map[string]bool{
+	"DBDSN":          true,
+	"GRPCToken":      true,
+	"GRPCHMACSecret": true,
+	"AdminKey":       true,
+	"MemoKey":        true,
+}
+
+// Redacted is the config as a map of field names to values for display,
+// with secrets replaced by "[redacted]" and durations written as strings.
+func (c *AppConfig) Redacted() map[string]any {
+	out := make(map[string]any)
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		f := v.Field(i)
+		switch {
+		case secretFields[name]:
+			if !f.IsZero() {
+				out[name] = "[redacted]"
+			} else {
+				out[name] = ""
+			}
+		case f.Type() == reflect.TypeOf(time.Duration(0)):
+			out[name] = time.Duration(f.Int()).String()
+		default:
+			out[name] = f.Interface()
+		}
+	}
+	return out
+}
+
+// Changed returns the names of the fields that differ between old and next.
+func Changed(old, next *AppConfig) []string {
+	var out []string
+	a, b := reflect.ValueOf(old).Elem(), reflect.ValueOf(next).Elem()
+	for i := 0; i < a.NumField(); i++ {
+		if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			out = append(out, a.Type().Field(i).Name)
+		}
+	}
+	return out
+}
+
+// Watch waits on Consul for changes under Prefix with blocking queries and
+// calls apply with the config loaded anew after each one, until ctx is done.
+// A config that fails to load is logged and skipped, so the service keeps
+// the last good one.
+func Watch(ctx context.Context, consulAddr string, apply func(*AppConfig), log *logrus.Logger) {
+	consulConf := consulapi.DefaultConfig()
+	consulConf.Address = consulAddr
+	client, err := consulapi.NewClient(consulConf)
+	if err != nil {
+		log.WithError(err).Error("config: consul client failed, config is not watched")
+		return
+	}
+	kv := client.KV()
+
+	var index uint64
+	backoff := time.Second
+	for {
+		opts := (&consulapi.QueryOptions{WaitIndex: index, WaitTime: 5 * time.Minute}).WithContext(ctx)
+		_, meta, err := kv.List(Prefix, opts)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.WithError(err).Warn("config: consul watch failed")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, 30*time.Second)
+			continue
+		}
+		backoff = time.Second
+
+		// индекс 0 не блокирует запрос, а уменьшение индекса значит, что
+		// Consul потерял состояние: ждём заново с начала
+		next := max(meta.LastIndex, 1)
+		if next < index {
+			next = 1
+		}
+		changed := index != 0 && next != index
+		index = next
+		if !changed {
+			continue
+		}
+
+		cfg, err := Load(ctx, consulAddr)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.WithError(err).Error("config: changed config is invalid, keeping the current one")
+			}
+			continue
+		}
+		apply(cfg)
+	}
+}
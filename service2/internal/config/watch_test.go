@@ -0,0 +1,143 @@
+package config_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/config"
+)
+
+// fakeKV serves Consul KV reads of keys and blocking lists of prefixes. Every
+// set bumps the index and wakes the blocked lists.
+type fakeKV struct {
+	mu      sync.Mutex
+	index   int
+	keys    map[string]string
+	changed chan struct{}
+	blocked int // сколько списков ждут изменения
+}
+
+func newFakeKV(keys map[string]string) *fakeKV {
+	return &fakeKV{index: 1, keys: keys, changed: make(chan struct{})}
+}
+
+func (f *fakeKV) set(key, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys[key] = value
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *fakeKV) waiting() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.blocked > 0
+}
+
+type kvPair struct {
+	Key   string
+	Value []byte
+}
+
+func (f *fakeKV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	q := r.URL.Query()
+	f.mu.Lock()
+	if _, recurse := q["recurse"]; recurse {
+		if index, _ := strconv.Atoi(q.Get("index")); index >= f.index {
+			changed := f.changed
+			f.blocked++
+			f.mu.Unlock()
+			select {
+			case <-changed:
+			case <-r.Context().Done():
+			}
+			f.mu.Lock()
+			f.blocked--
+			if r.Context().Err() != nil {
+				f.mu.Unlock()
+				return
+			}
+		}
+	}
+	defer f.mu.Unlock()
+
+	var out []kvPair
+	for k, v := range f.keys {
+		if k == key || (strings.HasSuffix(key, "/") && strings.HasPrefix(k, key)) {
+			out = append(out, kvPair{Key: k, Value: []byte(v)})
+		}
+	}
+	w.Header().Set("X-Consul-Index", strconv.Itoa(f.index))
+	if len(out) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+func TestWatch(t *testing.T) {
+	kv := newFakeKV(map[string]string{"config/service2/redis_ttl": "1m"})
+	srv := httptest.NewServer(kv)
+	defer srv.Close()
+	addr := strings.TrimPrefix(srv.URL, "http://")
+
+	cfg, err := config.Load(context.Background(), addr)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, cfg.CacheTTL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	applied := make(chan *config.AppConfig, 10)
+	go config.Watch(ctx, addr, func(c *config.AppConfig) { applied <- c }, logrus.New())
+
+	// первый ответ только запоминает индекс, следующий ждёт изменения
+	set := func(key, value string) {
+		require.Eventually(t, kv.waiting, 2*time.Second, time.Millisecond)
+		kv.set(key, value)
+	}
+	set("config/service2/redis_ttl", "5m")
+	select {
+	case c := <-applied:
+		require.Equal(t, 5*time.Minute, c.CacheTTL)
+	case <-time.After(2 * time.Second):
+		t.Fatal("change was not applied")
+	}
+
+	// неверная конфигурация пропускается
+	set("config/service2/log_level", "loud")
+	set("config/service2/log_level", "debug")
+	select {
+	case c := <-applied:
+		require.Equal(t, "debug", c.LogLevel)
+		require.Equal(t, []string{"CacheTTL", "LogLevel"}, config.Changed(cfg, c))
+	case <-time.After(2 * time.Second):
+		t.Fatal("change was not applied")
+	}
+	require.Empty(t, applied)
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := &config.AppConfig{DBDSN: "postgres://u:secret@db/hashes", CacheTTL: 5 * time.Minute, HTTPPort: "8080"}
+	out := cfg.Redacted()
+	require.Equal(t, "[redacted]", out["DBDSN"])
+	require.Equal(t, "", out["AdminKey"])
+	require.Equal(t, "5m0s", out["CacheTTL"])
+	require.Equal(t, "8080", out["HTTPPort"])
+
+	b, err := json.Marshal(out)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "secret")
+}
@@ -0,0 +1,88 @@
+package grpcclient_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"service2/internal/grpcclient"
+	"service2/proto/hasherpb"
+)
+
+const methodCalculate = "/hasher.HasherService/CalculateHashes"
+
+// sentMD calls the unary auth interceptor of cr with req and returns the
+// metadata it would send.
+func sentMD(t *testing.T, cr grpcclient.Credentials, req proto.Message) metadata.MD {
+	t.Helper()
+	var md metadata.MD
+	err := grpcclient.UnaryClientAuth(cr)(context.Background(), methodCalculate, req, nil, nil,
+		func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		})
+	require.NoError(t, err)
+	return md
+}
+
+// wantSignature computes the signature service1 expects, independently of
+// the client code.
+func wantSignature(secret, client, method string, md metadata.MD, body []byte) string {
+	digest := sha256.Sum256(body)
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(client + "\n" + method + "\n" + md.Get("x-timestamp")[0] + "\n" + md.Get("x-nonce")[0] + "\n" + hex.EncodeToString(digest[:])))
+	return hex.EncodeToString(m.Sum(nil))
+}
+
+func TestAuth_Token(t *testing.T) {
+	md := sentMD(t, grpcclient.Credentials{ClientID: "service2", Token: "t0ken"}, &hasherpb.HashRequest{})
+	require.Equal(t, []string{"Bearer t0ken"}, md.Get("authorization"))
+	require.Empty(t, md.Get("x-signature"))
+}
+
+func TestAuth_HMAC(t *testing.T) {
+	cr := grpcclient.Credentials{ClientID: "service2", HMACSecret: "s3cret"}
+	req := &hasherpb.HashRequest{Strings: []string{"a", "b"}}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	require.NoError(t, err)
+
+	md := sentMD(t, cr, req)
+	require.Equal(t, []string{"service2"}, md.Get("x-client-id"))
+	require.Empty(t, md.Get("authorization"))
+	sec, err := strconv.ParseInt(md.Get("x-timestamp")[0], 10, 64)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), time.Unix(sec, 0), 2*time.Second)
+
+	// подпись покрывает тело запроса
+	require.Equal(t, wantSignature("s3cret", "service2", methodCalculate, md, body), md.Get("x-signature")[0])
+	require.NotEqual(t, wantSignature("s3cret", "service2", methodCalculate, md, nil), md.Get("x-signature")[0])
+
+	// у каждого вызова свой nonce, даже с тем же телом
+	again := sentMD(t, cr, req)
+	require.NotEmpty(t, md.Get("x-nonce")[0])
+	require.NotEqual(t, md.Get("x-nonce"), again.Get("x-nonce"))
+	require.NotEqual(t, md.Get("x-signature"), again.Get("x-signature"))
+}
+
+func TestAuth_HMACStream(t *testing.T) {
+	cr := grpcclient.Credentials{ClientID: "service2", HMACSecret: "s3cret"}
+	method := "/hasher.HasherService/CalculateHashesStream"
+
+	var md metadata.MD
+	_, err := grpcclient.StreamClientAuth(cr)(context.Background(), &grpc.StreamDesc{}, nil, method,
+		func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil, nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, wantSignature("s3cret", "service2", method, md, nil), md.Get("x-signature")[0])
+}
@@ -2,27 +2,52 @@ package grpcclient
 
 import (
 	"context"
+	"fmt"
+	"io"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 
 	hasherpb "service2/proto/hasherpb"
 )
 
 type HasherClient interface {
 	Calculate(ctx context.Context, strings []string) ([]string, error)
+	// CalculateStream opens a stream for hashing binary items chunk by chunk.
+	CalculateStream(ctx context.Context) (HashStream, error)
+	// Ping asks service1 through grpc.health.v1 whether it serves hashing.
+	Ping(ctx context.Context) error
 	Close() error
 }
 
+// HashStream sends binary items to service1 without buffering them whole.
+// Write appends data to the current item, EndItem finishes it and Finish
+// returns the hashes of all finished items in the order they were sent.
+type HashStream interface {
+	io.Writer
+	EndItem() error
+	Finish() ([]string, error)
+}
+
 type client struct {
-	conn *grpc.ClientConn
-	c    hasherpb.HasherServiceClient
+	conn   *grpc.ClientConn
+	c      hasherpb.HasherServiceClient
+	health healthpb.HealthClient
 }
 
-func New(addr string, extra ...grpc.DialOption) (HasherClient, error) {
+// New connects to service1 at addr. creds secures the connection; nil means
+// plaintext.
+func New(addr string, creds credentials.TransportCredentials, extra ...grpc.DialOption) (HasherClient, error) {
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithChainUnaryInterceptor(UnaryClientInjectRequestID()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(UnaryClientInjectRequestID(), UnaryClientInjectSubject()),
+		grpc.WithChainStreamInterceptor(StreamClientInjectRequestID(), StreamClientInjectSubject()),
 	}
 	opts = append(opts, extra...)
 
@@ -30,18 +55,82 @@ func New(addr string, extra ...grpc.DialOption) (HasherClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &client{conn: conn, c: hasherpb.NewHasherServiceClient(conn)}, nil
+	return &client{conn: conn, c: hasherpb.NewHasherServiceClient(conn), health: healthpb.NewHealthClient(conn)}, nil
 }
 
 func (cl *client) Calculate(ctx context.Context, strings []string) ([]string, error) {
-	resp, err := cl.c.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: strings})
+	var hdr metadata.MD
+	resp, err := cl.c.CalculateHashes(ctx, &hasherpb.HashRequest{Strings: strings}, grpc.Header(&hdr))
 	if err != nil {
 		return nil, err
 	}
+	if vals := hdr.Get(mdAlgorithm); len(vals) > 0 {
+		ReportAlgorithm(ctx, vals[0])
+	}
 	return resp.GetHashes(), nil
 }
 
+func (cl *client) CalculateStream(ctx context.Context) (HashStream, error) {
+	st, err := cl.c.CalculateHashesStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &hashStream{st: st}, nil
+}
+
+func (cl *client) Ping(ctx context.Context) error {
+	resp, err := cl.health.Check(ctx, &healthpb.HealthCheckRequest{Service: hasherpb.HasherService_ServiceDesc.ServiceName})
+	if err != nil {
+		return err
+	}
+	if st := resp.GetStatus(); st != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service1 is %s", st)
+	}
+	return nil
+}
+
 func (cl *client) Close() error {
 	err := cl.conn.Close()
 	return err
 }
+
+type hashStream struct {
+	st hasherpb.HasherService_CalculateHashesStreamClient
+}
+
+func (s *hashStream) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if err := s.st.Send(&hasherpb.HashChunk{Data: p}); err != nil {
+		return 0, s.streamErr(err)
+	}
+	return len(p), nil
+}
+
+func (s *hashStream) EndItem() error {
+	if err := s.st.Send(&hasherpb.HashChunk{Last: true}); err != nil {
+		return s.streamErr(err)
+	}
+	return nil
+}
+
+func (s *hashStream) Finish() ([]string, error) {
+	resp, err := s.st.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetHashes(), nil
+}
+
+// streamErr replaces io.EOF returned by Send with the actual status the
+// server aborted the stream with.
+func (s *hashStream) streamErr(err error) error {
+	if err != io.EOF {
+		return err
+	}
+	if _, rerr := s.st.CloseAndRecv(); rerr != nil {
+		return rerr
+	}
+	return err
+}
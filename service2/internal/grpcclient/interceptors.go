@@ -18,13 +18,65 @@ func UnaryClientInjectRequestID() grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		id := mw.FromContext(ctx)
-		md, _ := metadata.FromOutgoingContext(ctx)
-		md = md.Copy()
-		if id != "" {
-			md.Set("x-request-id", id)
-		}
-		ctx = metadata.NewOutgoingContext(ctx, md)
-		return invoker(ctx, method, req, reply, cc, opts...)
+		return invoker(withRequestID(ctx), method, req, reply, cc, opts...)
 	}
 }
+
+func StreamClientInjectRequestID() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(withRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	id := mw.FromContext(ctx)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	if id != "" {
+		md.Set("x-request-id", id)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// UnaryClientInjectSubject forwards the authenticated caller of the HTTP
+// request to service1 as x-subject metadata.
+func UnaryClientInjectSubject() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return invoker(withSubject(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+func StreamClientInjectSubject() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(withSubject(ctx), desc, cc, method, opts...)
+	}
+}
+
+func withSubject(ctx context.Context) context.Context {
+	p := mw.PrincipalFromContext(ctx)
+	if p == nil || p.Subject == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "x-subject", p.Subject)
+}
@@ -0,0 +1,219 @@
+package grpcclient
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"service2/internal/cache"
+)
+
+var memoLookupsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "service2_memo_lookups_total",
+		Help: "Strings looked up in the memo before hashing, by result (hit, miss, skipped for being too long).",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(memoLookupsTotal)
+}
+
+// mdAlgorithm — заголовок ответа service1 с именем алгоритма хеширования
+const mdAlgorithm = "x-hash-algorithm"
+
+type ctxKeyAlgorithm struct{}
+
+// ReportAlgorithm tells Memo which algorithm service1 hashed a call with. A
+// HasherClient calls it with the ctx of Calculate; without it Memo assumes
+// the algorithm it already knows.
+func ReportAlgorithm(ctx context.Context, name string) {
+	if p, ok := ctx.Value(ctxKeyAlgorithm{}).(*string); ok {
+		*p = name
+	}
+}
+
+// Memo remembers the hashes of inputs, so that an input hashed before is not
+// sent to service1 again. Hashes are stored under an HMAC of the algorithm and
+// the input, so the inputs never reach the cache. The algorithm is the one
+// service1 reports with every call: hashes are only stored under the
+// algorithm they were made with, and a call that finds service1 on another
+// one skips the memo. Calls answered from the memo alone do not reach
+// service1, so Run checks the algorithm periodically; until it notices a
+// change, hashes of the old algorithm are served. Only Calculate is memoized;
+// streams go to service1 as is.
+type Memo struct {
+	HasherClient
+	cache cache.Cache
+	key   []byte
+	log   *logrus.Logger
+
+	ttl       atomic.Int64
+	algorithm atomic.Pointer[string]
+	// MaxBytes — строки длиннее не запоминаются, 0 — без ограничения
+	MaxBytes int
+}
+
+// NewMemo memoizes next in c. key keys the fingerprints and must be the same
+// on every instance sharing c; algorithm is the one service1 is expected to
+// hash with until it reports otherwise.
+func NewMemo(next HasherClient, c cache.Cache, key []byte, algorithm string, log *logrus.Logger) *Memo {
+	m := &Memo{HasherClient: next, cache: c, key: key, log: log}
+	m.algorithm.Store(&algorithm)
+	m.SetTTL(time.Hour)
+	return m
+}
+
+// SetTTL sets how long hashes are remembered; 0 turns memoization off and
+// sends every string to service1. It may be called while hashing.
+func (m *Memo) SetTTL(ttl time.Duration) {
+	m.ttl.Store(int64(ttl))
+}
+
+// Algorithm returns the algorithm service1 last reported.
+func (m *Memo) Algorithm() string {
+	return *m.algorithm.Load()
+}
+
+// Run asks service1 for its algorithm every interval until ctx is done, with
+// a call that hashes nothing.
+func (m *Memo) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		if m.ttl.Load() <= 0 {
+			continue
+		}
+		cctx, cancel := context.WithTimeout(ctx, interval)
+		_, _, err := m.hash(cctx, nil)
+		cancel()
+		if err != nil && ctx.Err() == nil {
+			m.log.WithError(err).Warn("memo: checking the algorithm of service1 failed")
+		}
+	}
+}
+
+// hash hashes strs in service1 and returns the algorithm it reported, or ""
+// if it did not.
+func (m *Memo) hash(ctx context.Context, strs []string) ([]string, string, error) {
+	var alg string
+	hashes, err := m.HasherClient.Calculate(context.WithValue(ctx, ctxKeyAlgorithm{}, &alg), strs)
+	if err != nil {
+		return nil, "", err
+	}
+	if alg != "" {
+		if old := m.algorithm.Swap(&alg); *old != alg {
+			m.log.WithField("old", *old).WithField("new", alg).Info("memo: service1 hashes with another algorithm")
+		}
+	}
+	return hashes, alg, nil
+}
+
+func (m *Memo) Calculate(ctx context.Context, strs []string) ([]string, error) {
+	ttl := time.Duration(m.ttl.Load())
+	if ttl <= 0 {
+		return m.HasherClient.Calculate(ctx, strs)
+	}
+	alg := m.Algorithm()
+	out := make([]string, len(strs))
+	keys := make([]string, 0, len(strs))
+	idx := make([]int, 0, len(strs))
+	for i, s := range strs {
+		if m.MaxBytes > 0 && len(s) > m.MaxBytes {
+			continue
+		}
+		keys = append(keys, m.fingerprint(alg, s))
+		idx = append(idx, i)
+	}
+	memoLookupsTotal.WithLabelValues("skipped").Add(float64(len(strs) - len(keys)))
+
+	if len(keys) > 0 {
+		vals, err := m.cache.Get(ctx, keys)
+		if err != nil {
+			m.logError("memo get failed", err)
+			vals = make([]string, len(keys))
+		}
+		hits := 0
+		for j, v := range vals {
+			if v != "" {
+				out[idx[j]] = v
+				hits++
+			}
+		}
+		memoLookupsTotal.WithLabelValues("hit").Add(float64(hits))
+		memoLookupsTotal.WithLabelValues("miss").Add(float64(len(keys) - hits))
+	}
+
+	// в service1 уходят только ненайденные строки, каждая один раз
+	var miss []string
+	pos := make(map[string]int)
+	for i, s := range strs {
+		if out[i] != "" {
+			continue
+		}
+		if _, ok := pos[s]; !ok {
+			pos[s] = len(miss)
+			miss = append(miss, s)
+		}
+	}
+	if len(miss) == 0 {
+		return out, nil
+	}
+	hashes, used, err := m.hash(ctx, miss)
+	if err != nil {
+		return nil, err
+	}
+	if used != "" && used != alg {
+		// service1 сменил алгоритм: найденные хеши устарели, а новые
+		// запоминаются со следующего вызова
+		return m.HasherClient.Calculate(ctx, strs)
+	}
+
+	entries := make([]cache.Entry, 0, len(miss))
+	for j, s := range miss {
+		if m.MaxBytes > 0 && len(s) > m.MaxBytes {
+			continue
+		}
+		entries = append(entries, cache.Entry{Key: m.fingerprint(alg, s), Value: hashes[j]})
+	}
+	for i, s := range strs {
+		if out[i] == "" {
+			out[i] = hashes[pos[s]]
+		}
+	}
+	if len(entries) > 0 {
+		if err := m.cache.SetMany(ctx, entries, ttl); err != nil {
+			m.logError("memo set failed", err)
+		}
+	}
+	return out, nil
+}
+
+// fingerprint returns the cache key of s hashed with alg.
+func (m *Memo) fingerprint(alg, s string) string {
+	h := hmac.New(sha256.New, m.key)
+	h.Write([]byte(alg))
+	h.Write([]byte{0})
+	h.Write([]byte(s))
+	return "memo:" + alg + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+func (m *Memo) logError(msg string, err error) {
+	if errors.Is(err, cache.ErrUnavailable) {
+		return
+	}
+	m.log.WithError(err).Warn(msg)
+}
@@ -0,0 +1,145 @@
+package grpcclient_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/cache"
+	"service2/internal/grpcclient"
+)
+
+// hasher "hashes" by upper-casing and records the strings it was sent.
+type hasher struct {
+	grpcclient.HasherClient
+	sent [][]string
+}
+
+func (h *hasher) Calculate(_ context.Context, strs []string) ([]string, error) {
+	h.sent = append(h.sent, strs)
+	out := make([]string, len(strs))
+	for i, s := range strs {
+		out[i] = strings.ToUpper(s)
+	}
+	return out, nil
+}
+
+func TestMemo(t *testing.T) {
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	c := cache.NewRedis(rdb)
+	next := &hasher{}
+	m := grpcclient.NewMemo(next, c, []byte("secret"), "sha3-256", logrus.New())
+	m.SetTTL(time.Minute)
+	m.MaxBytes = 3
+	ctx := context.Background()
+
+	out, err := m.Calculate(ctx, []string{"ab", "cd", "ab", "long"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"AB", "CD", "AB", "LONG"}, out)
+	require.Equal(t, [][]string{{"ab", "cd", "long"}}, next.sent)
+
+	// запомненные строки в service1 не уходят, длинные уходят всегда
+	out, err = m.Calculate(ctx, []string{"cd", "long", "ab", "ef"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"CD", "LONG", "AB", "EF"}, out)
+	require.Equal(t, []string{"long", "ef"}, next.sent[1])
+
+	// ключи — отпечатки строк, а не сами строки, и живут TTL
+	for _, k := range srv.Keys() {
+		require.Regexp(t, "^memo:sha3-256:[0-9a-f]{64}$", k)
+		require.Equal(t, time.Minute, srv.TTL(k))
+	}
+	require.Len(t, srv.Keys(), 3)
+
+	// другой алгоритм или ключ не видят чужих отпечатков
+	other := grpcclient.NewMemo(next, c, []byte("secret"), "sha256", logrus.New())
+	_, err = other.Calculate(ctx, []string{"ab"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ab"}, next.sent[2])
+
+	// с нулевым TTL запоминание выключено
+	m.SetTTL(0)
+	_, err = m.Calculate(ctx, []string{"ab"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ab"}, next.sent[3])
+	m.SetTTL(time.Minute)
+
+	// без кэша строки просто хешируются
+	srv.Close()
+	out, err = m.Calculate(ctx, []string{"ab"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"AB"}, out)
+	require.Equal(t, []string{"ab"}, next.sent[4])
+}
+
+// switching "hashes" by prefixing the algorithm it reports.
+type switching struct {
+	grpcclient.HasherClient
+	mu    sync.Mutex
+	alg   string
+	calls int
+}
+
+func (h *switching) setAlgorithm(alg string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.alg = alg
+}
+
+func (h *switching) Calculate(ctx context.Context, strs []string) ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls++
+	grpcclient.ReportAlgorithm(ctx, h.alg)
+	out := make([]string, len(strs))
+	for i, s := range strs {
+		out[i] = h.alg + ":" + s
+	}
+	return out, nil
+}
+
+func TestMemo_AlgorithmChange(t *testing.T) {
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	next := &switching{alg: "sha3-256"}
+	m := grpcclient.NewMemo(next, cache.NewRedis(rdb), []byte("secret"), "sha3-256", logrus.New())
+	m.SetTTL(time.Minute)
+	ctx := context.Background()
+
+	_, err := m.Calculate(ctx, []string{"a"})
+	require.NoError(t, err)
+
+	// промах показывает смену алгоритма, и запомненный хеш не отдаётся
+	next.setAlgorithm("sha256")
+	out, err := m.Calculate(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sha256:a", "sha256:b"}, out)
+	require.Equal(t, "sha256", m.Algorithm())
+	out, err = m.Calculate(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sha256:a"}, out)
+	out, err = m.Calculate(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sha256:a"}, out)
+	require.Equal(t, 4, next.calls)
+
+	// без промахов смену замечает Run
+	next.setAlgorithm("sha3-256")
+	rctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go m.Run(rctx, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return m.Algorithm() == "sha3-256" }, 2*time.Second, 10*time.Millisecond)
+	out, err = m.Calculate(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sha3-256:a"}, out)
+}
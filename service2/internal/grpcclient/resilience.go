@@ -0,0 +1,316 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var retriesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "service2_grpc_retries_total",
+		Help: "Calls to service1 retried, by the code of the failed attempt.",
+	},
+	[]string{"code"},
+)
+
+var breakerOpenTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "service2_grpc_breaker_rejected_total",
+	Help: "Calls to service1 failed fast by the open circuit breaker.",
+})
+
+var breakerState = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "service2_grpc_breaker_state",
+	Help: "State of the circuit breaker to service1: 0 closed, 1 open, 2 half-open.",
+})
+
+func init() {
+	prometheus.MustRegister(retriesTotal, breakerOpenTotal, breakerState)
+}
+
+// ErrBreakerOpen is wrapped by *BreakerOpenError.
+var ErrBreakerOpen = errors.New("service1 circuit breaker is open")
+
+// BreakerOpenError is returned instead of calling service1 while the breaker
+// is open; RetryAfter is the time left until it lets a probe through.
+type BreakerOpenError struct {
+	RetryAfter time.Duration
+}
+
+func (e *BreakerOpenError) Error() string { return ErrBreakerOpen.Error() }
+func (e *BreakerOpenError) Unwrap() error { return ErrBreakerOpen }
+
+// unavailableRetryAfter — Retry-After для ответа, когда service1 недоступен,
+// а брейкер ещё закрыт
+const unavailableRetryAfter = time.Second
+
+// RetryAfter reports whether err means that service1 is unavailable for now,
+// so that the request may be retried later, and when.
+func RetryAfter(err error) (time.Duration, bool) {
+	var be *BreakerOpenError
+	if errors.As(err, &be) {
+		return be.RetryAfter, true
+	}
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		switch se.GRPCStatus().Code() {
+		case codes.Unavailable, codes.ResourceExhausted:
+			return unavailableRetryAfter, true
+		}
+	}
+	return 0, false
+}
+
+// UnaryClientTimeout bounds every attempt of a unary call by d, or by the
+// deadline of ctx if it is sooner. d <= 0 disables it. Streams are not
+// bounded: they last as long as the upload.
+func UnaryClientTimeout(d time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if d <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// RetryPolicy configures UnaryClientRetry.
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt; 1 or less disables retries.
+	MaxAttempts int
+	// Backoff is the upper bound of the first pause; it doubles after every
+	// attempt up to MaxBackoff. The actual pause is uniformly random below
+	// the bound, so that clients cut off together do not come back together.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// UnaryClientRetry repeats a unary call that failed with Unavailable or
+// ResourceExhausted. Hashing is idempotent, so a repeated call is safe. It
+// gives up early when ctx is done. Health checks are not retried.
+func UnaryClientRetry(p RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if healthCheck(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		bound := p.Backoff
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= p.MaxAttempts || !retryable(err) {
+				return err
+			}
+			retriesTotal.WithLabelValues(status.Code(err).String()).Inc()
+
+			var pause time.Duration
+			if bound > 0 {
+				pause = rand.N(bound)
+			}
+			t := time.NewTimer(pause)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return err
+			case <-t.C:
+			}
+			bound = min(bound*2, p.MaxBackoff)
+		}
+	}
+}
+
+// healthCheck reports whether method is a health check. Readiness probes
+// call it periodically on their own, so it is neither retried nor counted by
+// the breaker: its success says nothing about hashing.
+func healthCheck(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/")
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// Breaker is a circuit breaker over calls to service1. After Failures
+// consecutive calls fail because service1 is down or does not answer in time,
+// it opens and fails calls fast with *BreakerOpenError for Cooldown. Then it
+// lets one probe through: success closes it, failure opens it again.
+type Breaker struct {
+	Failures int
+	Cooldown time.Duration
+
+	mu        sync.Mutex
+	failed    int
+	openUntil time.Time
+	probing   bool
+	// opened растёт при каждом открытии: результаты вызовов, начатых до
+	// него, уже ничего не говорят о service1
+	opened uint64
+}
+
+const (
+	breakerClosed = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// ticket is issued by allow for every call let through.
+type ticket struct {
+	opened uint64
+	probe  bool
+}
+
+// NewBreaker returns a closed breaker. failures <= 0 disables it.
+func NewBreaker(failures int, cooldown time.Duration) *Breaker {
+	return &Breaker{Failures: failures, Cooldown: cooldown}
+}
+
+// allow reports whether a call may go through. Every call let through must
+// report its result with done, or with abandon if it has none.
+func (b *Breaker) allow(now time.Time) (ticket, error) {
+	if b.Failures <= 0 {
+		return ticket{}, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failed < b.Failures {
+		return ticket{opened: b.opened}, nil
+	}
+	if left := b.openUntil.Sub(now); left > 0 || b.probing {
+		breakerOpenTotal.Inc()
+		return ticket{}, &BreakerOpenError{RetryAfter: max(left, time.Second)}
+	}
+	b.probing = true
+	breakerState.Set(breakerHalfOpen)
+	return ticket{opened: b.opened, probe: true}, nil
+}
+
+// done records the result of a call let through by allow. Results of calls
+// started before the breaker last opened are ignored.
+func (b *Breaker) done(t ticket, now time.Time, err error) {
+	if b.Failures <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if t.probe {
+		b.probing = false
+	}
+	if t.opened != b.opened {
+		return
+	}
+	if !tripping(err) {
+		b.failed = 0
+		breakerState.Set(breakerClosed)
+		return
+	}
+	b.failed++
+	if b.failed >= b.Failures {
+		b.openUntil = now.Add(b.Cooldown)
+		b.opened++
+		breakerState.Set(breakerOpen)
+	}
+}
+
+// abandon ends a call that tells nothing about service1, e.g. cancelled by
+// the caller: the breaker stays as it was, only the next call may probe.
+func (b *Breaker) abandon(t ticket) {
+	if !t.probe {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if b.failed >= b.Failures {
+		breakerState.Set(breakerOpen)
+	}
+}
+
+// finish reports err of a call made with ctx to b.
+func (b *Breaker) finish(ctx context.Context, t ticket, err error) {
+	if ctx.Err() != nil && status.Code(err) != codes.Unavailable {
+		// вызов прерван клиентом, о service1 это ничего не говорит
+		b.abandon(t)
+		return
+	}
+	b.done(t, time.Now(), err)
+}
+
+// tripping reports whether err tells that service1 is unhealthy. Calls
+// cancelled by the caller and errors in the request itself do not count.
+func tripping(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// UnaryClientBreaker fails unary calls fast while b is open. Health checks
+// bypass it.
+func UnaryClientBreaker(b *Breaker) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if healthCheck(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		t, err := b.allow(time.Now())
+		if err != nil {
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		b.finish(ctx, t, err)
+		return err
+	}
+}
+
+// StreamClientBreaker fails opening a stream fast while b is open. Only
+// opening the stream counts as a call.
+func StreamClientBreaker(b *Breaker) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		t, err := b.allow(time.Now())
+		if err != nil {
+			return nil, err
+		}
+		st, err := streamer(ctx, desc, cc, method, opts...)
+		b.finish(ctx, t, err)
+		return st, err
+	}
+}
@@ -0,0 +1,188 @@
+package grpcclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"service2/internal/grpcclient"
+)
+
+// invoker answers with the errors of errs in turn, then with nil, and counts
+// the calls.
+type invoker struct {
+	errs  []error
+	calls int
+}
+
+func (f *invoker) invoke(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func TestRetry(t *testing.T) {
+	retry := grpcclient.UnaryClientRetry(grpcclient.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond})
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	f := &invoker{errs: []error{unavailable, status.Error(codes.ResourceExhausted, "busy")}}
+	require.NoError(t, retry(context.Background(), "/m", nil, nil, nil, f.invoke))
+	require.Equal(t, 3, f.calls)
+
+	f = &invoker{errs: []error{unavailable, unavailable, unavailable}}
+	require.Equal(t, codes.Unavailable, status.Code(retry(context.Background(), "/m", nil, nil, nil, f.invoke)))
+	require.Equal(t, 3, f.calls)
+
+	// ошибки в самом запросе не повторяются
+	f = &invoker{errs: []error{status.Error(codes.InvalidArgument, "bad")}}
+	require.Error(t, retry(context.Background(), "/m", nil, nil, nil, f.invoke))
+	require.Equal(t, 1, f.calls)
+
+	// отменённый вызов не ждёт паузы
+	slow := grpcclient.UnaryClientRetry(grpcclient.RetryPolicy{MaxAttempts: 3, Backoff: time.Hour, MaxBackoff: time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	f = &invoker{errs: []error{unavailable, unavailable}}
+	require.Equal(t, codes.Unavailable, status.Code(slow(ctx, "/m", nil, nil, nil, f.invoke)))
+	require.Equal(t, 1, f.calls)
+}
+
+func TestTimeout(t *testing.T) {
+	timeout := grpcclient.UnaryClientTimeout(time.Second)
+	var deadline time.Time
+	err := timeout(context.Background(), "/m", nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			deadline, _ = ctx.Deadline()
+			return nil
+		})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+}
+
+func TestBreaker(t *testing.T) {
+	b := grpcclient.NewBreaker(2, 50*time.Millisecond)
+	call := grpcclient.UnaryClientBreaker(b)
+	ctx := context.Background()
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	f := &invoker{errs: []error{unavailable, unavailable, unavailable}}
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+
+	// открыт: service1 не вызывается
+	err := call(ctx, "/m", nil, nil, nil, f.invoke)
+	require.ErrorIs(t, err, grpcclient.ErrBreakerOpen)
+	require.Equal(t, 2, f.calls)
+	d, ok := grpcclient.RetryAfter(err)
+	require.True(t, ok)
+	require.Equal(t, time.Second, d)
+
+	// после паузы проба падает и открывает его снова
+	time.Sleep(60 * time.Millisecond)
+	require.Equal(t, codes.Unavailable, status.Code(call(ctx, "/m", nil, nil, nil, f.invoke)))
+	require.ErrorIs(t, call(ctx, "/m", nil, nil, nil, f.invoke), grpcclient.ErrBreakerOpen)
+
+	// удачная проба закрывает
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.NoError(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.Equal(t, 5, f.calls)
+
+	// ошибки запроса брейкер не считает
+	f = &invoker{errs: []error{status.Error(codes.InvalidArgument, "bad"), status.Error(codes.InvalidArgument, "bad")}}
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.NoError(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+}
+
+func TestBreaker_Cancelled(t *testing.T) {
+	b := grpcclient.NewBreaker(2, 50*time.Millisecond)
+	call := grpcclient.UnaryClientBreaker(b)
+	ctx := context.Background()
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	unavailable := status.Error(codes.Unavailable, "down")
+	canceledErr := status.Error(codes.Canceled, "canceled")
+
+	// отменённый вызов не сбрасывает счётчик неудач
+	f := &invoker{errs: []error{unavailable, canceledErr, unavailable}}
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.Error(t, call(cancelled, "/m", nil, nil, nil, f.invoke))
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.ErrorIs(t, call(ctx, "/m", nil, nil, nil, f.invoke), grpcclient.ErrBreakerOpen)
+
+	// отменённая проба не закрывает брейкер, следующий вызов снова проба
+	time.Sleep(60 * time.Millisecond)
+	f = &invoker{errs: []error{canceledErr, unavailable}}
+	require.Error(t, call(cancelled, "/m", nil, nil, nil, f.invoke))
+	require.Equal(t, codes.Unavailable, status.Code(call(ctx, "/m", nil, nil, nil, f.invoke)))
+	require.ErrorIs(t, call(ctx, "/m", nil, nil, nil, f.invoke), grpcclient.ErrBreakerOpen)
+	require.Equal(t, 2, f.calls)
+}
+
+func TestBreaker_StaleSuccess(t *testing.T) {
+	b := grpcclient.NewBreaker(2, time.Hour)
+	call := grpcclient.UnaryClientBreaker(b)
+	ctx := context.Background()
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	started, release := make(chan struct{}), make(chan struct{})
+	slow := make(chan error)
+	go func() {
+		slow <- call(ctx, "/m", nil, nil, nil,
+			func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				close(started)
+				<-release
+				return nil
+			})
+	}()
+	<-started
+
+	f := &invoker{errs: []error{unavailable, unavailable}}
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+
+	// ответ вызова, начатого до открытия, брейкер не закрывает
+	close(release)
+	require.NoError(t, <-slow)
+	require.ErrorIs(t, call(ctx, "/m", nil, nil, nil, f.invoke), grpcclient.ErrBreakerOpen)
+}
+
+func TestHealthCheckBypass(t *testing.T) {
+	const method = "/grpc.health.v1.Health/Check"
+	ctx := context.Background()
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	// проверка здоровья не повторяется
+	retry := grpcclient.UnaryClientRetry(grpcclient.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	f := &invoker{errs: []error{unavailable}}
+	require.Error(t, retry(ctx, method, nil, nil, nil, f.invoke))
+	require.Equal(t, 1, f.calls)
+
+	// и не открывает и не закрывает брейкер
+	b := grpcclient.NewBreaker(1, time.Hour)
+	call := grpcclient.UnaryClientBreaker(b)
+	f = &invoker{errs: []error{unavailable, unavailable}}
+	require.Error(t, call(ctx, method, nil, nil, nil, f.invoke))
+	require.Error(t, call(ctx, "/m", nil, nil, nil, f.invoke))
+	require.NoError(t, call(ctx, method, nil, nil, nil, f.invoke))
+	require.ErrorIs(t, call(ctx, "/m", nil, nil, nil, f.invoke), grpcclient.ErrBreakerOpen)
+	require.Equal(t, 3, f.calls)
+}
+
+func TestRetryAfter(t *testing.T) {
+	_, ok := grpcclient.RetryAfter(status.Error(codes.Internal, "boom"))
+	require.False(t, ok)
+	d, ok := grpcclient.RetryAfter(status.Error(codes.Unavailable, "down"))
+	require.True(t, ok)
+	require.Equal(t, time.Second, d)
+}
@@ -0,0 +1,142 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+// ConsulScheme is the scheme of targets resolved through Consul:
+// consul://<agent address>/<service name>.
+const ConsulScheme = "consul"
+
+// consulWait — сколько агент держит блокирующий запрос без изменений
+const consulWait = 5 * time.Minute
+
+// consulRetryMax — предельная пауза между запросами к недоступному агенту
+const consulRetryMax = 30 * time.Second
+
+// Consul returns the target and dial options that spread calls round-robin
+// over the instances of service that pass their Consul health checks. The
+// list follows the catalog as instances come, go and fail checks. The
+// authority, and so the TLS server name, is the service name.
+func Consul(agent, service string) (string, []grpc.DialOption) {
+	target := fmt.Sprintf("%s://%s/%s", ConsulScheme, agent, service)
+	return target, []grpc.DialOption{
+		grpc.WithResolvers(ConsulResolver()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingConfig":[{"round_robin":{}}]}`),
+		grpc.WithAuthority(service),
+	}
+}
+
+// ConsulResolver builds resolvers for ConsulScheme targets. It is passed to
+// the client with grpc.WithResolvers rather than registered globally.
+func ConsulResolver() resolver.Builder {
+	return consulBuilder{}
+}
+
+type consulBuilder struct{}
+
+func (consulBuilder) Scheme() string { return ConsulScheme }
+
+func (consulBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	service := strings.TrimPrefix(target.URL.Path, "/")
+	if target.URL.Host == "" || service == "" {
+		return nil, errors.Errorf("consul target must be consul://agent/service, got %q", target.URL.String())
+	}
+	conf := consulapi.DefaultConfig()
+	conf.Address = target.URL.Host
+	client, err := consulapi.NewClient(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "consul client failed")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &consulResolver{
+		health:  client.Health(),
+		service: service,
+		cc:      cc,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go r.watch()
+	return r, nil
+}
+
+// consulResolver keeps the addresses of healthy instances up to date with
+// blocking queries, so changes reach the balancer as soon as Consul sees them.
+type consulResolver struct {
+	health  *consulapi.Health
+	service string
+	cc      resolver.ClientConn
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (r *consulResolver) watch() {
+	defer close(r.done)
+
+	var index uint64
+	pause := time.Second
+	for {
+		opts := (&consulapi.QueryOptions{WaitIndex: index, WaitTime: consulWait}).WithContext(r.ctx)
+		entries, meta, err := r.health.Service(r.service, "", true, opts)
+		if r.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			r.cc.ReportError(errors.Wrapf(err, "resolve %s in consul", r.service))
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-time.After(pause):
+			}
+			pause = min(pause*2, consulRetryMax)
+			continue
+		}
+		pause = time.Second
+
+		if meta.LastIndex == index {
+			// блокирующий запрос истёк без изменений
+			continue
+		}
+		// индекс может уменьшиться, например после пересоздания каталога, —
+		// тогда ждём от нового; с нулём запрос не блокируется вовсе
+		index = max(meta.LastIndex, 1)
+
+		addrs := make([]resolver.Address, 0, len(entries))
+		for _, e := range entries {
+			host := e.Service.Address
+			if host == "" {
+				host = e.Node.Address
+			}
+			addrs = append(addrs, resolver.Address{Addr: net.JoinHostPort(host, strconv.Itoa(e.Service.Port))})
+		}
+		if len(addrs) == 0 {
+			r.cc.ReportError(errors.Errorf("no healthy %s instances in consul", r.service))
+			continue
+		}
+		slices.SortFunc(addrs, func(a, b resolver.Address) int { return strings.Compare(a.Addr, b.Addr) })
+		_ = r.cc.UpdateState(resolver.State{Addresses: addrs})
+	}
+}
+
+// ResolveNow does nothing: the blocking query already waits for changes.
+func (r *consulResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+func (r *consulResolver) Close() {
+	r.cancel()
+	<-r.done
+}
@@ -0,0 +1,129 @@
+package grpcclient_test
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
+
+	"service2/internal/grpcclient"
+)
+
+// fakeConsul answers health queries of service1: a query with index i gets
+// catalog[i] and index i+1, a query past the catalog blocks until the client
+// goes away.
+type fakeConsul struct {
+	catalog [][]string
+}
+
+type healthEntry struct {
+	Node    struct{ Address string }
+	Service struct {
+		Address string
+		Port    int
+	}
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/health/service/service1" || r.URL.Query().Get("passing") == "" {
+		http.NotFound(w, r)
+		return
+	}
+	index, _ := strconv.Atoi(r.URL.Query().Get("index"))
+	if index >= len(f.catalog) {
+		<-r.Context().Done()
+		return
+	}
+
+	out := []healthEntry{}
+	for _, addr := range f.catalog[index] {
+		host, port, _ := net.SplitHostPort(addr)
+		var e healthEntry
+		e.Service.Address = host
+		e.Service.Port, _ = strconv.Atoi(port)
+		out = append(out, e)
+	}
+	w.Header().Set("X-Consul-Index", strconv.Itoa(index+1))
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// clientConn records what the resolver reports.
+type clientConn struct {
+	resolver.ClientConn
+	states chan resolver.State
+	errs   chan error
+}
+
+func (cc *clientConn) UpdateState(s resolver.State) error {
+	cc.states <- s
+	return nil
+}
+
+func (cc *clientConn) ReportError(err error) {
+	cc.errs <- err
+}
+
+func (cc *clientConn) ParseServiceConfig(string) *serviceconfig.ParseResult {
+	return &serviceconfig.ParseResult{}
+}
+
+func TestConsulResolver(t *testing.T) {
+	srv := httptest.NewServer(&fakeConsul{catalog: [][]string{
+		{"10.0.0.2:50051", "10.0.0.1:50051"},
+		{},
+		{"10.0.0.1:50051"},
+	}})
+	defer srv.Close()
+
+	target, _ := grpcclient.Consul(strings.TrimPrefix(srv.URL, "http://"), "service1")
+	u, err := url.Parse(target)
+	require.NoError(t, err)
+
+	cc := &clientConn{states: make(chan resolver.State, 4), errs: make(chan error, 4)}
+	r, err := grpcclient.ConsulResolver().Build(resolver.Target{URL: *u}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	next := func() []string {
+		t.Helper()
+		select {
+		case s := <-cc.states:
+			var out []string
+			for _, a := range s.Addresses {
+				out = append(out, a.Addr)
+			}
+			return out
+		case err := <-cc.errs:
+			t.Fatalf("unexpected error: %v", err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("no update from the resolver")
+		}
+		return nil
+	}
+	require.Equal(t, []string{"10.0.0.1:50051", "10.0.0.2:50051"}, next())
+
+	// без здоровых экземпляров — ошибка вместо пустого списка
+	select {
+	case err := <-cc.errs:
+		require.ErrorContains(t, err, "no healthy service1")
+	case <-time.After(2 * time.Second):
+		t.Fatal("no error from the resolver")
+	}
+
+	require.Equal(t, []string{"10.0.0.1:50051"}, next())
+}
+
+func TestConsulResolver_BadTarget(t *testing.T) {
+	cc := &clientConn{states: make(chan resolver.State, 1), errs: make(chan error, 1)}
+	_, err := grpcclient.ConsulResolver().Build(resolver.Target{URL: url.URL{Scheme: "consul", Host: "consul:8500"}}, cc, resolver.BuildOptions{})
+	require.Error(t, err)
+}
@@ -0,0 +1,173 @@
+// Package health serves the liveness and readiness probes of service2.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// Check reports whether a dependency is usable; nil means it is.
+type Check func(ctx context.Context) error
+
+// Checker runs the checks of the dependencies service2 needs to serve
+// requests. Results are cached for TTL so that frequent probes from several
+// balancers do not load the dependencies. The probes are not authenticated,
+// so errors of failed checks are only logged, never returned.
+type Checker struct {
+	// Timeout bounds each check.
+	Timeout time.Duration
+	// TTL is how long results are reused.
+	TTL time.Duration
+
+	log *logrus.Logger
+
+	names    []string
+	checks   []Check
+	optional []bool
+
+	shuttingDown atomic.Bool
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	report    Report
+}
+
+// Report is the body of /readyz.
+type Report struct {
+	Status string                `json:"status"`
+	Checks map[string]Dependency `json:"checks,omitempty"`
+}
+
+// Dependency is the result of one check.
+type Dependency struct {
+	Status    string  `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+	// Optional dependencies do not make the instance not ready.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// Статусы в ответах проб.
+const (
+	StatusOK           = "ok"
+	StatusReady        = "ready"
+	StatusDegraded     = "degraded"
+	StatusNotReady     = "not_ready"
+	StatusShuttingDown = "shutting_down"
+	StatusUp           = "up"
+	StatusDown         = "down"
+)
+
+// New returns a Checker without checks, which is ready. Dependencies going
+// down and back up are logged to log.
+func New(timeout, ttl time.Duration, log *logrus.Logger) *Checker {
+	return &Checker{Timeout: timeout, TTL: ttl, log: log}
+}
+
+// Add registers a check of the dependency called name. Checks must be added
+// before the first probe.
+func (h *Checker) Add(name string, check Check) {
+	h.add(name, check, false)
+}
+
+// AddOptional registers a check of a dependency service2 can serve without,
+// such as the cache. While it fails the instance is ready but "degraded".
+func (h *Checker) AddOptional(name string, check Check) {
+	h.add(name, check, true)
+}
+
+func (h *Checker) add(name string, check Check, optional bool) {
+	h.names = append(h.names, name)
+	h.checks = append(h.checks, check)
+	h.optional = append(h.optional, optional)
+}
+
+// Shutdown makes the instance not ready for good, so that balancers stop
+// sending requests while the server drains the ones in flight.
+func (h *Checker) Shutdown() {
+	h.shuttingDown.Store(true)
+}
+
+// Status runs the checks, or reuses results younger than TTL, and reports
+// whether all of them pass.
+func (h *Checker) Status(ctx context.Context) (Report, bool) {
+	if h.shuttingDown.Load() {
+		return Report{Status: StatusShuttingDown}, false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.report.Status == "" || time.Since(h.checkedAt) >= h.TTL {
+		// результат общий для всех проб, поэтому отмена одной его не портит
+		h.report = h.run(context.WithoutCancel(ctx))
+		h.checkedAt = time.Now()
+	}
+	return h.report, h.report.Status != StatusNotReady
+}
+
+// run runs all checks in parallel and logs the ones whose status changed
+// since the last run.
+func (h *Checker) run(ctx context.Context) Report {
+	deps := make([]Dependency, len(h.checks))
+	errs := make([]error, len(h.checks))
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(ctx, h.Timeout)
+			defer cancel()
+			start := time.Now()
+			err := check(cctx)
+			deps[i] = Dependency{Status: StatusUp, LatencyMS: float64(time.Since(start).Microseconds()) / 1000, Optional: h.optional[i]}
+			if err != nil {
+				deps[i].Status = StatusDown
+				errs[i] = err
+			}
+		}()
+	}
+	wg.Wait()
+
+	r := Report{Status: StatusReady, Checks: make(map[string]Dependency, len(deps))}
+	for i, d := range deps {
+		name := h.names[i]
+		// подробности ошибки — адреса и порты внутренних сервисов, в ответ
+		// пробы они не попадают
+		switch prev := h.report.Checks[name].Status; {
+		case d.Status == StatusDown && prev != StatusDown:
+			h.log.WithField("dependency", name).WithError(errs[i]).Warn("health: dependency is down")
+		case d.Status == StatusUp && prev == StatusDown:
+			h.log.WithField("dependency", name).Info("health: dependency is up again")
+		}
+		r.Checks[name] = d
+		switch {
+		case d.Status == StatusUp:
+		case !d.Optional:
+			r.Status = StatusNotReady
+		case r.Status == StatusReady:
+			r.Status = StatusDegraded
+		}
+	}
+	return r
+}
+
+// Live handles GET /healthz: the process is up and serving HTTP.
+func (h *Checker) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, Report{Status: StatusOK})
+}
+
+// Ready handles GET /readyz: 200 when every required dependency is up, 503
+// otherwise and during shutdown, with the result of each check.
+func (h *Checker) Ready(c *gin.Context) {
+	report, ok := h.Status(c.Request.Context())
+	status := http.StatusOK
+	if !ok {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
@@ -0,0 +1,140 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/health"
+)
+
+func probe(t *testing.T, r *gin.Engine, path string) (int, health.Report) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	var rep health.Report
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rep))
+	return w.Code, rep
+}
+
+func TestChecker(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var redisDown atomic.Bool
+	var calls atomic.Int32
+	log, hook := logtest.NewNullLogger()
+	h := health.New(50*time.Millisecond, time.Hour, log)
+	h.Add("postgres", func(context.Context) error { calls.Add(1); return nil })
+	h.Add("redis", func(context.Context) error {
+		if redisDown.Load() {
+			return errors.New("dial tcp 10.0.0.5:6379: connection refused")
+		}
+		return nil
+	})
+	h.Add("service1", func(ctx context.Context) error {
+		// зависшая проверка обрывается по таймауту
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	r := gin.New()
+	r.GET("/healthz", h.Live)
+	r.GET("/readyz", h.Ready)
+
+	code, rep := probe(t, r, "/healthz")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, health.StatusOK, rep.Status)
+
+	code, rep = probe(t, r, "/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, health.StatusNotReady, rep.Status)
+	require.Equal(t, health.StatusUp, rep.Checks["postgres"].Status)
+	require.Equal(t, health.StatusUp, rep.Checks["redis"].Status)
+	require.Equal(t, health.StatusDown, rep.Checks["service1"].Status)
+	// причина только в логе
+	entry := hook.LastEntry()
+	require.Equal(t, "service1", entry.Data["dependency"])
+	require.ErrorIs(t, entry.Data[logrus.ErrorKey].(error), context.DeadlineExceeded)
+
+	// в пределах TTL проверки не повторяются
+	redisDown.Store(true)
+	_, rep = probe(t, r, "/readyz")
+	require.Equal(t, health.StatusUp, rep.Checks["redis"].Status)
+	require.EqualValues(t, 1, calls.Load())
+
+	h.Shutdown()
+	code, rep = probe(t, r, "/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, health.StatusShuttingDown, rep.Status)
+	code, _ = probe(t, r, "/healthz")
+	require.Equal(t, http.StatusOK, code)
+}
+
+func TestChecker_Ready(t *testing.T) {
+	h := health.New(time.Second, 0, logrus.New())
+	h.Add("postgres", func(context.Context) error { return nil })
+	rep, ok := h.Status(context.Background())
+	require.True(t, ok)
+	require.Equal(t, health.StatusReady, rep.Status)
+}
+
+func TestChecker_Optional(t *testing.T) {
+	h := health.New(time.Second, 0, logrus.New())
+	h.Add("postgres", func(context.Context) error { return nil })
+	h.AddOptional("redis", func(context.Context) error { return errors.New("connection refused") })
+
+	// без кэша экземпляр обслуживает запросы
+	rep, ok := h.Status(context.Background())
+	require.True(t, ok)
+	require.Equal(t, health.StatusDegraded, rep.Status)
+	require.True(t, rep.Checks["redis"].Optional)
+
+	h.Add("service1", func(context.Context) error { return errors.New("unavailable") })
+	rep, ok = h.Status(context.Background())
+	require.False(t, ok)
+	require.Equal(t, health.StatusNotReady, rep.Status)
+}
+
+func TestChecker_HidesErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var down atomic.Bool
+	down.Store(true)
+	log, hook := logtest.NewNullLogger()
+	h := health.New(time.Second, 0, log)
+	h.AddOptional("redis", func(context.Context) error {
+		if down.Load() {
+			return errors.New("dial tcp 10.0.0.5:6379: connection refused")
+		}
+		return nil
+	})
+	r := gin.New()
+	r.GET("/readyz", h.Ready)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotContains(t, w.Body.String(), "10.0.0.5")
+	require.NotContains(t, w.Body.String(), "error")
+	require.Contains(t, w.Body.String(), `"status":"down"`)
+
+	// смена статуса пишется в лог один раз, а не на каждую пробу
+	_, _ = h.Status(context.Background())
+	require.Len(t, hook.AllEntries(), 1)
+	require.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+	require.Contains(t, hook.LastEntry().Data[logrus.ErrorKey].(error).Error(), "10.0.0.5")
+
+	down.Store(false)
+	_, _ = h.Status(context.Background())
+	require.Len(t, hook.AllEntries(), 2)
+	require.Equal(t, "health: dependency is up again", hook.LastEntry().Message)
+}
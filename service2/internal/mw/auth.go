@@ -0,0 +1,182 @@
+package mw
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Scopes granted to API clients.
+const (
+	ScopeHashWrite = "hash:write"
+	ScopeHashRead  = "hash:read"
+	// ScopeAdmin grants every other scope and access to key management.
+	ScopeAdmin = "admin"
+)
+
+// KnownScopes lists all scopes that can be granted.
+var KnownScopes = []string{ScopeHashWrite, ScopeHashRead, ScopeAdmin}
+
+const HeaderAPIKey = "X-API-Key"
+
+// Коды ошибок аутентификации в ответах problem+json.
+const (
+	CodeUnauthorized = "unauthorized"
+	CodeForbidden    = "forbidden"
+)
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when the request carries
+	// no credentials it understands, so the next one should be tried.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials is returned when credentials are present but wrong.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Tenant  string
+	Subject string
+	Scopes  []string
+	// Anonymous is set for requests without credentials when authentication
+	// is not required.
+	Anonymous bool
+}
+
+// HasScope reports whether p was granted scope.
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope) || slices.Contains(p.Scopes, ScopeAdmin)
+}
+
+type ctxKeyPrincipal struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, ctxKeyPrincipal{}, p)
+}
+
+// PrincipalFromContext returns the caller set by Auth, or nil.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	if p, ok := ctx.Value(ctxKeyPrincipal{}).(*Principal); ok {
+		return p
+	}
+	return nil
+}
+
+// Authenticator checks the credentials carried by a request.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Principal, error)
+}
+
+// Auth authenticates every request with the first authenticator that finds
+// credentials in it and stores the caller in the request context. Requests
+// without credentials are rejected when required is set; otherwise they run
+// as anonymous.
+func Auth(required bool, anonymous Principal, authenticators ...Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var p *Principal
+		for _, a := range authenticators {
+			got, err := a.Authenticate(c.Request)
+			if errors.Is(err, ErrNoCredentials) {
+				continue
+			}
+			if errors.Is(err, ErrInvalidCredentials) {
+				_ = c.Error(err)
+				AbortWithProblem(c, http.StatusUnauthorized, CodeUnauthorized, err.Error(), nil)
+				return
+			}
+			if err != nil {
+				_ = c.Error(err)
+				AbortWithProblem(c, http.StatusInternalServerError, "internal", "failed to check credentials", nil)
+				return
+			}
+			p = got
+			break
+		}
+		if p == nil {
+			if required {
+				c.Writer.Header().Add("WWW-Authenticate", "ApiKey")
+				c.Writer.Header().Add("WWW-Authenticate", "Bearer")
+				AbortWithProblem(c, http.StatusUnauthorized, CodeUnauthorized, "credentials required", nil)
+				return
+			}
+			anon := anonymous
+			anon.Anonymous = true
+			p = &anon
+		}
+		c.Request = c.Request.WithContext(WithPrincipal(c.Request.Context(), p))
+		c.Next()
+	}
+}
+
+// RequireScope rejects callers that were not granted scope.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p := PrincipalFromContext(c.Request.Context())
+		if p == nil || !p.HasScope(scope) {
+			AbortWithProblem(c, http.StatusForbidden, CodeForbidden, "missing scope "+scope, nil)
+			return
+		}
+		c.Next()
+	}
+}
+
+// APIKeyLookup resolves the hash of an API key to its principal. It returns
+// nil without an error when the key is unknown or revoked.
+type APIKeyLookup func(ctx context.Context, keyHash string) (*Principal, error)
+
+// APIKeys authenticates requests by an API key passed in the X-API-Key header
+// or as "Authorization: ApiKey <key>".
+type APIKeys struct {
+	Lookup APIKeyLookup
+	// Static holds keys configured outside the DB, such as the bootstrap admin
+	// key, by their hash.
+	Static map[string]Principal
+}
+
+func (a *APIKeys) Authenticate(r *http.Request) (*Principal, error) {
+	key := r.Header.Get(HeaderAPIKey)
+	if key == "" {
+		if scheme, v, ok := strings.Cut(r.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "ApiKey") {
+			key = strings.TrimSpace(v)
+		}
+	}
+	if key == "" {
+		return nil, ErrNoCredentials
+	}
+
+	h := HashAPIKey(key)
+	if p, ok := a.Static[h]; ok {
+		return &p, nil
+	}
+	p, err := a.Lookup(r.Context(), h)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, ErrInvalidCredentials
+	}
+	return p, nil
+}
+
+// HashAPIKey returns the form in which API keys are stored and compared.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewAPIKey generates a random API key.
+func NewAPIKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "hsk_" + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,91 @@
+package mw_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/mw"
+)
+
+func newAuthRouter(required bool, keys *mw.APIKeys, scope string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	anonymous := mw.Principal{Tenant: "default", Scopes: []string{mw.ScopeHashRead}}
+	r.GET("/", mw.Auth(required, anonymous, keys), mw.RequireScope(scope), func(c *gin.Context) {
+		c.String(http.StatusOK, mw.PrincipalFromContext(c.Request.Context()).Tenant)
+	})
+	return r
+}
+
+func doAuth(r http.Handler, header, value string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if header != "" {
+		req.Header.Set(header, value)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuth_APIKey(t *testing.T) {
+	keys := &mw.APIKeys{Lookup: func(_ context.Context, keyHash string) (*mw.Principal, error) {
+		if keyHash == mw.HashAPIKey("team-a-key") {
+			return &mw.Principal{Tenant: "team-a", Scopes: []string{mw.ScopeHashRead}}, nil
+		}
+		return nil, nil
+	}}
+	r := newAuthRouter(true, keys, mw.ScopeHashRead)
+
+	w := doAuth(r, mw.HeaderAPIKey, "team-a-key")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "team-a", w.Body.String())
+
+	w = doAuth(r, "Authorization", "ApiKey team-a-key")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = doAuth(r, mw.HeaderAPIKey, "wrong")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Equal(t, mw.MimeProblem, w.Header().Get("Content-Type"))
+
+	w = doAuth(r, "", "")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestAuth_Scopes(t *testing.T) {
+	keys := &mw.APIKeys{
+		Lookup: func(context.Context, string) (*mw.Principal, error) { return nil, nil },
+		Static: map[string]mw.Principal{
+			mw.HashAPIKey("reader"): {Tenant: "a", Scopes: []string{mw.ScopeHashRead}},
+			mw.HashAPIKey("admin"):  {Tenant: "a", Scopes: []string{mw.ScopeAdmin}},
+		},
+	}
+	r := newAuthRouter(true, keys, mw.ScopeHashWrite)
+
+	require.Equal(t, http.StatusForbidden, doAuth(r, mw.HeaderAPIKey, "reader").Code)
+	require.Equal(t, http.StatusOK, doAuth(r, mw.HeaderAPIKey, "admin").Code)
+}
+
+func TestAuth_NotRequired(t *testing.T) {
+	keys := &mw.APIKeys{Lookup: func(context.Context, string) (*mw.Principal, error) { return nil, nil }}
+
+	w := doAuth(newAuthRouter(false, keys, mw.ScopeHashRead), "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "default", w.Body.String())
+
+	// анонимный доступ не расширяет права
+	require.Equal(t, http.StatusForbidden, doAuth(newAuthRouter(false, keys, mw.ScopeAdmin), "", "").Code)
+}
+
+func TestAuth_LookupError(t *testing.T) {
+	keys := &mw.APIKeys{Lookup: func(context.Context, string) (*mw.Principal, error) {
+		return nil, errors.New("db is down")
+	}}
+	w := doAuth(newAuthRouter(true, keys, mw.ScopeHashRead), mw.HeaderAPIKey, "any")
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,220 @@
+package mw
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// KeySet resolves the public key a token was signed with by its key id.
+type KeySet interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+var errUnknownKey = errors.New("unknown signing key")
+
+func isUnknownKey(err error) bool { return errors.Is(err, errUnknownKey) }
+
+// StaticKeys is a fixed key set by key id. It is used for JWKS loaded from a
+// file and as a local stub in tests.
+type StaticKeys map[string]crypto.PublicKey
+
+func (s StaticKeys) Key(_ context.Context, kid string) (crypto.PublicKey, error) {
+	if k, ok := s[kid]; ok {
+		return k, nil
+	}
+	// токен без kid допустим, если ключ единственный
+	if kid == "" && len(s) == 1 {
+		for _, k := range s {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %q", errUnknownKey, kid)
+}
+
+// LoadJWKSFile reads a JWKS document from path.
+func LoadJWKSFile(path string) (StaticKeys, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJWKS(data)
+}
+
+// ParseJWKS parses the RSA and EC signing keys of a JWKS document (RFC 7517).
+// Keys of other types or meant for encryption are skipped.
+func ParseJWKS(data []byte) (StaticKeys, error) {
+	var doc struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+
+	keys := make(StaticKeys, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			n, err1 := b64Int(k.N)
+			e, err2 := b64Int(k.E)
+			if err := errors.Join(err1, err2); err != nil {
+				return nil, fmt.Errorf("parse jwks key %q: %w", k.Kid, err)
+			}
+			keys[k.Kid] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case "EC":
+			var curve elliptic.Curve
+			switch k.Crv {
+			case "P-256":
+				curve = elliptic.P256()
+			case "P-384":
+				curve = elliptic.P384()
+			case "P-521":
+				curve = elliptic.P521()
+			default:
+				continue
+			}
+			x, err1 := b64Int(k.X)
+			y, err2 := b64Int(k.Y)
+			if err := errors.Join(err1, err2); err != nil {
+				return nil, fmt.Errorf("parse jwks key %q: %w", k.Kid, err)
+			}
+			keys[k.Kid] = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("parse jwks: no signing keys")
+	}
+	return keys, nil
+}
+
+func b64Int(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// RemoteKeys fetches a JWKS document from an OIDC provider and caches it.
+// The document is refetched after TTL and, at most once per MinRefresh, when
+// a token is signed with an unknown key so that key rotation is picked up.
+// Cached keys keep being used while the provider is unreachable. Concurrent
+// refetches share one request, and lookups that the cached keys answer never
+// wait for it.
+type RemoteKeys struct {
+	URL        string
+	Client     *http.Client
+	TTL        time.Duration
+	MinRefresh time.Duration
+
+	flight  singleflight.Group
+	mu      sync.Mutex
+	keys    StaticKeys
+	fetched time.Time
+}
+
+func NewRemoteKeys(url string) *RemoteKeys {
+	return &RemoteKeys{
+		URL:        url,
+		Client:     &http.Client{Timeout: 5 * time.Second},
+		TTL:        time.Hour,
+		MinRefresh: time.Minute,
+	}
+}
+
+func (r *RemoteKeys) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	r.mu.Lock()
+	keys, fetched := r.keys, r.fetched
+	r.mu.Unlock()
+
+	if keys != nil && time.Since(fetched) < r.TTL {
+		key, err := keys.Key(ctx, kid)
+		if err == nil || time.Since(fetched) < r.MinRefresh {
+			return key, err
+		}
+	}
+
+	// запрос общий для всех ждущих, поэтому отмена одного из них его не прерывает
+	ch := r.flight.DoChan("", func() (any, error) {
+		next, err := r.fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		r.keys, r.fetched = next, time.Now()
+		r.mu.Unlock()
+		return next, nil
+	})
+	var res singleflight.Result
+	select {
+	case res = <-ch:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if res.Err != nil {
+		if keys != nil {
+			return keys.Key(ctx, kid)
+		}
+		return nil, res.Err
+	}
+	return res.Val.(StaticKeys).Key(ctx, kid)
+}
+
+func (r *RemoteKeys) fetch(ctx context.Context) (StaticKeys, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch jwks: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	return ParseJWKS(data)
+}
+
+// NewKeySet returns a key set for a JWKS source, which is either an http(s)
+// URL or a path to a local file.
+func NewKeySet(source string) (KeySet, error) {
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		return NewRemoteKeys(source), nil
+	}
+	return LoadJWKSFile(source)
+}
@@ -0,0 +1,97 @@
+package mw
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultTenantClaim is the claim read as the tenant when JWT.TenantClaim is
+// empty.
+const DefaultTenantClaim = "tenant_id"
+
+// JWT authenticates requests by an OIDC access token passed as
+// "Authorization: Bearer <token>". The token signature is checked against
+// Keys; iss and aud are checked when Issuer and Audience are set.
+//
+// Claims are mapped to the principal as follows: sub is the subject,
+// TenantClaim is the tenant and the space-separated scope claim (or scp as
+// used by some providers) holds the scopes.
+type JWT struct {
+	Keys        KeySet
+	Issuer      string
+	Audience    string
+	TenantClaim string
+}
+
+var jwtMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+func (a *JWT) Authenticate(r *http.Request) (*Principal, error) {
+	scheme, raw, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, ErrNoCredentials
+	}
+
+	opts := []jwt.ParserOption{jwt.WithValidMethods(jwtMethods), jwt.WithExpirationRequired()}
+	if a.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(a.Issuer))
+	}
+	if a.Audience != "" {
+		opts = append(opts, jwt.WithAudience(a.Audience))
+	}
+
+	// keyErr отличает недоступный JWKS (500) от неверного токена (401)
+	var keyErr error
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(strings.TrimSpace(raw), claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := a.Keys.Key(r.Context(), kid)
+		if err != nil && !isUnknownKey(err) {
+			keyErr = err
+		}
+		return key, err
+	}, opts...)
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	sub, _ := claims.GetSubject()
+	if sub == "" {
+		return nil, fmt.Errorf("%w: token has no sub claim", ErrInvalidCredentials)
+	}
+	tenantClaim := a.TenantClaim
+	if tenantClaim == "" {
+		tenantClaim = DefaultTenantClaim
+	}
+	tenant, _ := claims[tenantClaim].(string)
+	if tenant == "" {
+		return nil, fmt.Errorf("%w: token has no %s claim", ErrInvalidCredentials, tenantClaim)
+	}
+
+	return &Principal{Tenant: tenant, Subject: sub, Scopes: tokenScopes(claims)}, nil
+}
+
+// tokenScopes reads scopes from the OAuth 2.0 "scope" claim or from "scp",
+// which may be either a space-separated string or an array.
+func tokenScopes(claims jwt.MapClaims) []string {
+	for _, name := range []string{"scope", "scp"} {
+		switch v := claims[name].(type) {
+		case string:
+			return strings.Fields(v)
+		case []any:
+			out := make([]string, 0, len(v))
+			for _, s := range v {
+				if s, ok := s.(string); ok {
+					out = append(out, s)
+				}
+			}
+			return out
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package mw_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/mw"
+)
+
+func signToken(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	tok := jwt.NewWithClaims(method, claims)
+	tok.Header["kid"] = kid
+	s, err := tok.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":       "https://idp.example",
+		"aud":       "service2",
+		"sub":       "user-1",
+		"tenant_id": "team-a",
+		"scope":     "openid hash:read",
+		"exp":       time.Now().Add(time.Minute).Unix(),
+	}
+}
+
+func bearer(tok string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+tok)
+	return r
+}
+
+func TestJWT_Claims(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	a := &mw.JWT{
+		Keys:     mw.StaticKeys{"k1": &key.PublicKey},
+		Issuer:   "https://idp.example",
+		Audience: "service2",
+	}
+
+	p, err := a.Authenticate(bearer(signToken(t, jwt.SigningMethodRS256, key, "k1", validClaims())))
+	require.NoError(t, err)
+	require.Equal(t, "user-1", p.Subject)
+	require.Equal(t, "team-a", p.Tenant)
+	require.True(t, p.HasScope(mw.ScopeHashRead))
+	require.False(t, p.HasScope(mw.ScopeHashWrite))
+
+	claims := validClaims()
+	delete(claims, "scope")
+	claims["scp"] = []string{"hash:write"}
+	p, err = a.Authenticate(bearer(signToken(t, jwt.SigningMethodRS256, key, "k1", claims)))
+	require.NoError(t, err)
+	require.Equal(t, []string{mw.ScopeHashWrite}, p.Scopes)
+
+	_, err = a.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+	require.ErrorIs(t, err, mw.ErrNoCredentials)
+}
+
+func TestJWT_Rejects(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	a := &mw.JWT{Keys: mw.StaticKeys{"k1": &key.PublicKey}, Issuer: "https://idp.example", Audience: "service2"}
+
+	with := func(k string, v any) jwt.MapClaims {
+		c := validClaims()
+		if v == nil {
+			delete(c, k)
+		} else {
+			c[k] = v
+		}
+		return c
+	}
+	cases := map[string]string{
+		"expired":      signToken(t, jwt.SigningMethodRS256, key, "k1", with("exp", time.Now().Add(-time.Minute).Unix())),
+		"no exp":       signToken(t, jwt.SigningMethodRS256, key, "k1", with("exp", nil)),
+		"wrong issuer": signToken(t, jwt.SigningMethodRS256, key, "k1", with("iss", "https://evil.example")),
+		"wrong aud":    signToken(t, jwt.SigningMethodRS256, key, "k1", with("aud", "other")),
+		"no tenant":    signToken(t, jwt.SigningMethodRS256, key, "k1", with("tenant_id", nil)),
+		"no subject":   signToken(t, jwt.SigningMethodRS256, key, "k1", with("sub", nil)),
+		"bad sig":      signToken(t, jwt.SigningMethodRS256, other, "k1", validClaims()),
+		"unknown kid":  signToken(t, jwt.SigningMethodRS256, key, "k2", validClaims()),
+		"hmac":         signToken(t, jwt.SigningMethodHS256, []byte("secret"), "k1", validClaims()),
+		"garbage":      "not-a-token",
+	}
+	for name, tok := range cases {
+		_, err := a.Authenticate(bearer(tok))
+		require.ErrorIs(t, err, mw.ErrInvalidCredentials, name)
+	}
+}
+
+func TestJWT_RemoteJWKS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	b64 := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.FillBytes(make([]byte, 32))) }
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{
+			{"kty": "EC", "kid": "ec1", "use": "sig", "crv": "P-256", "x": b64(key.X), "y": b64(key.Y)},
+			{"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"},
+		}})
+	}))
+	defer srv.Close()
+
+	keys, err := mw.NewKeySet(srv.URL)
+	require.NoError(t, err)
+	a := &mw.JWT{Keys: keys}
+
+	p, err := a.Authenticate(bearer(signToken(t, jwt.SigningMethodES256, key, "ec1", validClaims())))
+	require.NoError(t, err)
+	require.Equal(t, "team-a", p.Tenant)
+
+	_, err = a.Authenticate(bearer(signToken(t, jwt.SigningMethodES256, key, "enc", validClaims())))
+	require.ErrorIs(t, err, mw.ErrInvalidCredentials)
+}
+
+func TestJWT_JWKSUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	a := &mw.JWT{Keys: mw.NewRemoteKeys(srv.URL)}
+
+	_, err = a.Authenticate(bearer(signToken(t, jwt.SigningMethodRS256, key, "k1", validClaims())))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, mw.ErrInvalidCredentials)
+}
+
+func TestRemoteKeys_SlowRefresh(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	b64 := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.FillBytes(make([]byte, 32))) }
+
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// все запросы, кроме первого, висят до release
+		if fetches.Add(1) > 1 {
+			<-release
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{
+			{"kty": "EC", "kid": "ec1", "crv": "P-256", "x": b64(key.X), "y": b64(key.Y)},
+		}})
+	}))
+	defer srv.Close()
+
+	keys := mw.NewRemoteKeys(srv.URL)
+	keys.MinRefresh = 0
+	ctx := context.Background()
+	_, err = keys.Key(ctx, "ec1")
+	require.NoError(t, err)
+
+	// неизвестный ключ ждёт нового запроса
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() {
+			_, err := keys.Key(ctx, "ec2")
+			errs <- err
+		}()
+	}
+	require.Eventually(t, func() bool { return fetches.Load() == 2 }, time.Second, time.Millisecond)
+
+	// известный ключ выдаётся, не дожидаясь их
+	_, err = keys.Key(ctx, "ec1")
+	require.NoError(t, err)
+
+	close(release)
+	require.Error(t, <-errs)
+	require.Error(t, <-errs)
+}
@@ -29,6 +29,9 @@ func HTTPLogger(log *logrus.Logger) gin.HandlerFunc {
 			"duration":   time.Since(start).String(),
 			"client_ip":  c.ClientIP(),
 		})
+		if p := PrincipalFromContext(c.Request.Context()); p != nil {
+			entry = entry.WithFields(logrus.Fields{"subject": p.Subject, "tenant": p.Tenant})
+		}
 		if len(c.Errors) > 0 {
 			entry.WithField("errors", c.Errors.String()).Error("http request end with errors")
 		} else {
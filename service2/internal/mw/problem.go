@@ -0,0 +1,48 @@
+package mw
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const MimeProblem = "application/problem+json"
+
+// problemTypePrefix prefixes error codes to form the RFC 7807 type URI.
+const problemTypePrefix = "urn:hashing-system:problem:"
+
+// Problem is an RFC 7807 problem details body.
+type Problem struct {
+	Type      string      `json:"type"`
+	Title     string      `json:"title"`
+	Status    int         `json:"status"`
+	Detail    string      `json:"detail,omitempty"`
+	Instance  string      `json:"instance,omitempty"`
+	Code      string      `json:"code"`
+	RequestID string      `json:"request_id,omitempty"`
+	Errors    []ItemError `json:"errors,omitempty"`
+}
+
+// ItemError describes why a single element of a batch request was not
+// processed. Index is the position of the element in the request.
+type ItemError struct {
+	Index  int    `json:"index"`
+	Code   string `json:"code"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// AbortWithProblem aborts the request with an application/problem+json body.
+// code is a stable machine-readable error code.
+func AbortWithProblem(c *gin.Context, status int, code, detail string, items []ItemError) {
+	c.Header("Content-Type", MimeProblem)
+	c.AbortWithStatusJSON(status, Problem{
+		Type:      problemTypePrefix + code,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		Instance:  c.Request.URL.Path,
+		Code:      code,
+		RequestID: FromContext(c.Request.Context()),
+		Errors:    items,
+	})
+}
@@ -0,0 +1,399 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+// CodeRateLimited is the problem code of requests rejected by RateLimit or
+// TakeDailyQuota.
+const CodeRateLimited = "rate_limited"
+
+var rateLimitedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "service2_rate_limited_total",
+		Help: "Requests rejected by rate limits and daily quotas.",
+	},
+	[]string{"limit"},
+)
+
+var rateLimiterFallbackTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "service2_rate_limiter_fallback_total",
+	Help: "Rate limit decisions made in memory because Redis failed.",
+})
+
+func init() {
+	prometheus.MustRegister(rateLimitedTotal, rateLimiterFallbackTotal)
+}
+
+// Decision is the outcome of Limiter.Take.
+type Decision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time until the budget is fully restored.
+	Reset time.Duration
+	// RetryAfter is the time until the rejected request would be allowed.
+	RetryAfter time.Duration
+}
+
+// Limiter spends n units of the budget of key if there are enough of them.
+type Limiter interface {
+	Take(ctx context.Context, key string, n int) (Decision, error)
+}
+
+// Rate configures a token bucket: Burst tokens at most, refilled at PerSecond.
+type Rate struct {
+	PerSecond float64
+	Burst     int
+}
+
+func (r Rate) fullIn(tokens float64) time.Duration {
+	return time.Duration((float64(r.Burst) - tokens) / r.PerSecond * float64(time.Second))
+}
+
+func (r Rate) decision(tokens float64, n int, allowed bool) Decision {
+	d := Decision{Allowed: allowed, Limit: r.Burst, Remaining: int(tokens), Reset: r.fullIn(tokens)}
+	if !allowed {
+		d.RetryAfter = time.Duration((float64(n) - tokens) / r.PerSecond * float64(time.Second))
+	}
+	return d
+}
+
+// MemoryTokenBucket keeps token buckets in process memory. Each instance of
+// service2 limits on its own, so it is meant as a fallback for Redis.
+type MemoryTokenBucket struct {
+	rate Rate
+
+	mu      sync.Mutex
+	buckets map[string]*memBucket
+	sweep   time.Time
+}
+
+type memBucket struct {
+	tokens float64
+	at     time.Time
+}
+
+func NewMemoryTokenBucket(rate Rate) *MemoryTokenBucket {
+	return &MemoryTokenBucket{rate: rate, buckets: map[string]*memBucket{}, sweep: time.Now()}
+}
+
+func (m *MemoryTokenBucket) Take(_ context.Context, key string, n int) (Decision, error) {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// полные корзины ничем не отличаются от отсутствующих, их можно выбросить
+	if full := m.rate.fullIn(0); now.Sub(m.sweep) > full {
+		for k, b := range m.buckets {
+			if now.Sub(b.at) > full {
+				delete(m.buckets, k)
+			}
+		}
+		m.sweep = now
+	}
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &memBucket{tokens: float64(m.rate.Burst), at: now}
+		m.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(m.rate.Burst), b.tokens+now.Sub(b.at).Seconds()*m.rate.PerSecond)
+	b.at = now
+
+	allowed := b.tokens >= float64(n)
+	if allowed {
+		b.tokens -= float64(n)
+	}
+	return m.rate.decision(b.tokens, n, allowed), nil
+}
+
+// tokenBucketScript refills and spends the bucket atomically. Time comes from
+// the Redis server so that instances with skewed clocks share one bucket.
+var tokenBucketScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+
+local b = redis.call('HMGET', KEYS[1], 'tokens', 'at')
+local tokens = tonumber(b[1]) or burst
+local at = tonumber(b[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - at) * rate)
+
+local allowed = 0
+if tokens >= n then
+  tokens = tokens - n
+  allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'at', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+return {allowed, tostring(tokens)}
+`)
+
+// RedisTokenBucket keeps token buckets in Redis, shared by all instances.
+type RedisTokenBucket struct {
+	rdb    *redis.Client
+	rate   Rate
+	prefix string
+}
+
+func NewRedisTokenBucket(rdb *redis.Client, rate Rate) *RedisTokenBucket {
+	return &RedisTokenBucket{rdb: rdb, rate: rate, prefix: "ratelimit:"}
+}
+
+func (r *RedisTokenBucket) Take(ctx context.Context, key string, n int) (Decision, error) {
+	res, err := tokenBucketScript.Run(ctx, r.rdb, []string{r.prefix + key}, r.rate.Burst, r.rate.PerSecond, n).Slice()
+	if err != nil {
+		return Decision{}, err
+	}
+	if len(res) != 2 {
+		return Decision{}, fmt.Errorf("ratelimit: unexpected reply %v", res)
+	}
+	allowed, _ := res[0].(int64)
+	s, _ := res[1].(string)
+	tokens, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return Decision{}, fmt.Errorf("ratelimit: bad tokens %q: %w", s, err)
+	}
+	return r.rate.decision(tokens, n, allowed == 1), nil
+}
+
+// untilMidnight is the time until the daily quotas reset, at 00:00 UTC.
+func untilMidnight(now time.Time) time.Duration {
+	now = now.UTC()
+	return now.Truncate(24 * time.Hour).Add(24 * time.Hour).Sub(now)
+}
+
+// MemoryDailyQuota counts usage per UTC day in process memory. A negative n
+// gives units back.
+type MemoryDailyQuota struct {
+	limit int
+
+	mu   sync.Mutex
+	day  string
+	used map[string]int
+}
+
+func NewMemoryDailyQuota(limit int) *MemoryDailyQuota {
+	return &MemoryDailyQuota{limit: limit, used: map[string]int{}}
+}
+
+func (m *MemoryDailyQuota) Take(_ context.Context, key string, n int) (Decision, error) {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if day := now.UTC().Format(time.DateOnly); day != m.day {
+		m.day, m.used = day, map[string]int{}
+	}
+	reset := untilMidnight(now)
+	used := m.used[key]
+	if used+n > m.limit {
+		return Decision{Limit: m.limit, Remaining: m.limit - used, Reset: reset, RetryAfter: reset}, nil
+	}
+	m.used[key] = max(used+n, 0)
+	return Decision{Allowed: true, Limit: m.limit, Remaining: m.limit - m.used[key], Reset: reset}, nil
+}
+
+// dailyQuotaScript adds n to the counter unless that exceeds the limit and
+// returns the counter, which never goes below zero.
+var dailyQuotaScript = redis.NewScript(`
+local used = tonumber(redis.call('GET', KEYS[1]) or '0')
+local limit = tonumber(ARGV[1])
+local n = tonumber(ARGV[2])
+if n > 0 and used + n > limit then
+  return {0, used}
+end
+used = math.max(used + n, 0)
+redis.call('SET', KEYS[1], used, 'EX', 172800)
+return {1, used}
+`)
+
+// RedisDailyQuota counts usage per UTC day in Redis, shared by all instances.
+// A negative n gives units back.
+type RedisDailyQuota struct {
+	rdb    *redis.Client
+	limit  int
+	prefix string
+}
+
+func NewRedisDailyQuota(rdb *redis.Client, limit int) *RedisDailyQuota {
+	return &RedisDailyQuota{rdb: rdb, limit: limit, prefix: "quota:"}
+}
+
+func (r *RedisDailyQuota) Take(ctx context.Context, key string, n int) (Decision, error) {
+	now := time.Now()
+	k := r.prefix + key + ":" + now.UTC().Format(time.DateOnly)
+	res, err := dailyQuotaScript.Run(ctx, r.rdb, []string{k}, r.limit, n).Int64Slice()
+	if err != nil {
+		return Decision{}, err
+	}
+	if len(res) != 2 {
+		return Decision{}, fmt.Errorf("quota: unexpected reply %v", res)
+	}
+	reset := untilMidnight(now)
+	d := Decision{Allowed: res[0] == 1, Limit: r.limit, Remaining: r.limit - int(res[1]), Reset: reset}
+	if !d.Allowed {
+		d.RetryAfter = reset
+	}
+	return d, nil
+}
+
+// Fallback uses Secondary while Primary fails, typically an in-memory limiter
+// standing in for Redis. Switches between them are logged once.
+type Fallback struct {
+	Primary   Limiter
+	Secondary Limiter
+	// Healthy, если задан, сообщает, доступен ли Primary; пока нет, он не
+	// вызывается вовсе
+	Healthy func() bool
+	Log     *logrus.Logger
+
+	degraded atomic.Bool
+}
+
+func (f *Fallback) Take(ctx context.Context, key string, n int) (Decision, error) {
+	if f.Healthy != nil && !f.Healthy() {
+		if f.degraded.CompareAndSwap(false, true) {
+			f.Log.Warn("ratelimit: redis is unavailable, limiting in memory")
+		}
+		rateLimiterFallbackTotal.Inc()
+		return f.Secondary.Take(ctx, key, n)
+	}
+	d, err := f.Primary.Take(ctx, key, n)
+	if err == nil {
+		if f.degraded.CompareAndSwap(true, false) {
+			f.Log.Info("ratelimit: redis is back, limits are shared again")
+		}
+		return d, nil
+	}
+	if f.degraded.CompareAndSwap(false, true) {
+		f.Log.WithError(err).Warn("ratelimit: redis failed, limiting in memory")
+	}
+	rateLimiterFallbackTotal.Inc()
+	return f.Secondary.Take(ctx, key, n)
+}
+
+// ErrDailyQuotaExceeded is returned by TakeDailyQuota, wrapped in a
+// *QuotaError.
+var ErrDailyQuotaExceeded = errors.New("daily quota exceeded")
+
+// QuotaError tells when the caller may retry.
+type QuotaError struct {
+	RetryAfter time.Duration
+}
+
+func (e *QuotaError) Error() string { return ErrDailyQuotaExceeded.Error() }
+func (e *QuotaError) Unwrap() error { return ErrDailyQuotaExceeded }
+
+// RateLimits configures RateLimit. Nil limiters are not applied.
+type RateLimits struct {
+	// Requests is spent by one unit per request.
+	Requests Limiter
+	// DailyStrings is spent by handlers through TakeDailyQuota by the number
+	// of strings they hash and given back through RefundDailyQuota, so it
+	// must accept a negative n.
+	DailyStrings Limiter
+}
+
+type ctxKeyQuota struct{}
+
+type dailyQuota struct {
+	limiter Limiter
+	key     string
+}
+
+// RateLimit limits requests per client: per principal for authenticated
+// requests and per client IP for anonymous ones. It sets the RateLimit-Limit,
+// RateLimit-Remaining and RateLimit-Reset headers and rejects requests over
+// the limit with 429 and Retry-After. Limiter errors are recorded with
+// c.Error and the request is let through.
+func RateLimit(l RateLimits) gin.HandlerFunc {
+	return RateLimitFunc(func() RateLimits { return l })
+}
+
+// RateLimitFunc is RateLimit with the limits returned by load for every
+// request, so that they can be changed while serving.
+func RateLimitFunc(load func() RateLimits) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		l := load()
+		key := "ip:" + c.ClientIP()
+		if p := PrincipalFromContext(c.Request.Context()); p != nil && !p.Anonymous {
+			key = "sub:" + p.Tenant + ":" + p.Subject
+		}
+
+		if l.Requests != nil {
+			d, err := l.Requests.Take(c.Request.Context(), key, 1)
+			if err != nil {
+				_ = c.Error(err)
+			} else {
+				setRateLimitHeaders(c, d)
+				if !d.Allowed {
+					rateLimitedTotal.WithLabelValues("requests").Inc()
+					c.Header("Retry-After", seconds(d.RetryAfter))
+					AbortWithProblem(c, http.StatusTooManyRequests, CodeRateLimited, "too many requests", nil)
+					return
+				}
+			}
+		}
+
+		if l.DailyStrings != nil {
+			ctx := context.WithValue(c.Request.Context(), ctxKeyQuota{}, dailyQuota{limiter: l.DailyStrings, key: key})
+			c.Request = c.Request.WithContext(ctx)
+		}
+		c.Next()
+	}
+}
+
+// TakeDailyQuota spends n units of the daily quota of the caller. It returns
+// a *QuotaError when the quota is used up and nil when no quota applies or
+// the quota store failed, so that an outage does not block hashing.
+func TakeDailyQuota(ctx context.Context, n int) error {
+	q, ok := ctx.Value(ctxKeyQuota{}).(dailyQuota)
+	if !ok || n == 0 {
+		return nil
+	}
+	d, err := q.limiter.Take(ctx, q.key, n)
+	if err != nil || d.Allowed {
+		return nil
+	}
+	rateLimitedTotal.WithLabelValues("daily_strings").Inc()
+	return &QuotaError{RetryAfter: d.RetryAfter}
+}
+
+// RefundDailyQuota gives back n units spent by TakeDailyQuota on work that
+// failed, so that failed requests and their retries do not use up the quota.
+// It still works after ctx is cancelled.
+func RefundDailyQuota(ctx context.Context, n int) {
+	q, ok := ctx.Value(ctxKeyQuota{}).(dailyQuota)
+	if !ok || n == 0 {
+		return
+	}
+	_, _ = q.limiter.Take(context.WithoutCancel(ctx), q.key, -n)
+}
+
+func setRateLimitHeaders(c *gin.Context, d Decision) {
+	c.Header("RateLimit-Limit", strconv.Itoa(d.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(max(d.Remaining, 0)))
+	c.Header("RateLimit-Reset", seconds(d.Reset))
+}
+
+// seconds rounds d up to whole seconds as used by Retry-After.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
@@ -0,0 +1,204 @@
+package mw_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/mw"
+)
+
+func newRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return srv, rdb
+}
+
+func TestTokenBucket(t *testing.T) {
+	_, rdb := newRedis(t)
+	rate := mw.Rate{PerSecond: 1, Burst: 3}
+
+	for name, l := range map[string]mw.Limiter{
+		"memory": mw.NewMemoryTokenBucket(rate),
+		"redis":  mw.NewRedisTokenBucket(rdb, rate),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for i := 2; i >= 0; i-- {
+				d, err := l.Take(ctx, "a", 1)
+				require.NoError(t, err)
+				require.True(t, d.Allowed)
+				require.Equal(t, 3, d.Limit)
+				require.Equal(t, i, d.Remaining)
+			}
+
+			d, err := l.Take(ctx, "a", 1)
+			require.NoError(t, err)
+			require.False(t, d.Allowed)
+			require.Greater(t, d.RetryAfter, time.Duration(0))
+			require.LessOrEqual(t, d.RetryAfter, time.Second)
+
+			// у другого ключа своя корзина
+			d, err = l.Take(ctx, "b", 1)
+			require.NoError(t, err)
+			require.True(t, d.Allowed)
+		})
+	}
+}
+
+func TestDailyQuota(t *testing.T) {
+	_, rdb := newRedis(t)
+
+	for name, l := range map[string]mw.Limiter{
+		"memory": mw.NewMemoryDailyQuota(10),
+		"redis":  mw.NewRedisDailyQuota(rdb, 10),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			d, err := l.Take(ctx, "a", 7)
+			require.NoError(t, err)
+			require.True(t, d.Allowed)
+			require.Equal(t, 3, d.Remaining)
+
+			// запрос, не влезающий в остаток, не расходует квоту
+			d, err = l.Take(ctx, "a", 4)
+			require.NoError(t, err)
+			require.False(t, d.Allowed)
+			require.LessOrEqual(t, d.RetryAfter, 24*time.Hour)
+
+			d, err = l.Take(ctx, "a", 3)
+			require.NoError(t, err)
+			require.True(t, d.Allowed)
+			require.Equal(t, 0, d.Remaining)
+
+			// возврат освобождает квоту, но не уводит её ниже нуля
+			d, err = l.Take(ctx, "a", -4)
+			require.NoError(t, err)
+			require.Equal(t, 4, d.Remaining)
+			d, err = l.Take(ctx, "a", -20)
+			require.NoError(t, err)
+			require.Equal(t, 10, d.Remaining)
+		})
+	}
+}
+
+func TestFallback(t *testing.T) {
+	srv, rdb := newRedis(t)
+	rate := mw.Rate{PerSecond: 1, Burst: 1}
+	l := &mw.Fallback{
+		Primary:   mw.NewRedisTokenBucket(rdb, rate),
+		Secondary: mw.NewMemoryTokenBucket(rate),
+		Log:       logrus.New(),
+	}
+	ctx := context.Background()
+
+	srv.Close()
+	d, err := l.Take(ctx, "a", 1)
+	require.NoError(t, err)
+	require.True(t, d.Allowed)
+	d, err = l.Take(ctx, "a", 1)
+	require.NoError(t, err)
+	require.False(t, d.Allowed)
+}
+
+func TestFallback_Unhealthy(t *testing.T) {
+	srv, rdb := newRedis(t)
+	rate := mw.Rate{PerSecond: 1, Burst: 1}
+	l := &mw.Fallback{
+		Primary:   mw.NewRedisTokenBucket(rdb, rate),
+		Secondary: mw.NewMemoryTokenBucket(rate),
+		Healthy:   func() bool { return false },
+		Log:       logrus.New(),
+	}
+
+	// пока Redis считается недоступным, он не вызывается, хоть и работает
+	d, err := l.Take(context.Background(), "a", 1)
+	require.NoError(t, err)
+	require.True(t, d.Allowed)
+	require.Empty(t, srv.Keys())
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	limits := mw.RateLimits{
+		Requests:     mw.NewMemoryTokenBucket(mw.Rate{PerSecond: 0.5, Burst: 2}),
+		DailyStrings: mw.NewMemoryDailyQuota(5),
+	}
+	r.POST("/send", mw.RateLimit(limits), func(c *gin.Context) {
+		err := mw.TakeDailyQuota(c.Request.Context(), 3)
+		var qe *mw.QuotaError
+		if errors.As(err, &qe) {
+			c.Status(http.StatusTooManyRequests)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+	send := func(ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/send", nil)
+		req.RemoteAddr = ip + ":1234"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := send("10.0.0.1")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+	require.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+
+	// второй запрос проходит лимит запросов, но не дневную квоту строк
+	w = send("10.0.0.1")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Empty(t, w.Header().Get("Retry-After"))
+
+	w = send("10.0.0.1")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, mw.MimeProblem, w.Header().Get("Content-Type"))
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+	require.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+
+	require.Equal(t, http.StatusOK, send("10.0.0.2").Code)
+}
+
+func TestRefundDailyQuota(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	limits := mw.RateLimits{DailyStrings: mw.NewMemoryDailyQuota(5)}
+	r.POST("/send", mw.RateLimit(limits), func(c *gin.Context) {
+		ctx := c.Request.Context()
+		if err := mw.TakeDailyQuota(ctx, 4); err != nil {
+			c.Status(http.StatusTooManyRequests)
+			return
+		}
+		// неудавшаяся запись возвращает квоту
+		if c.Query("fail") != "" {
+			mw.RefundDailyQuota(ctx, 4)
+			c.Status(http.StatusServiceUnavailable)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+	send := func(target string) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, target, nil))
+		return w.Code
+	}
+
+	for range 3 {
+		require.Equal(t, http.StatusServiceUnavailable, send("/send?fail=1"))
+	}
+	require.Equal(t, http.StatusOK, send("/send"))
+	require.Equal(t, http.StatusTooManyRequests, send("/send"))
+}
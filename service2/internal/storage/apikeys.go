@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// APIKey is a stored API key. Only the SHA-256 of the key is kept, the key
+// itself is shown once when it is created.
+type APIKey struct {
+	ID        int64
+	Tenant    string
+	Name      string
+	Scopes    []string
+	CreatedAt time.Time
+}
+
+// LookupAPIKey returns the active key with the given hash, or nil if there is
+// no such key or it was revoked.
+func (s *Store) LookupAPIKey(ctx context.Context, keyHash string) (*APIKey, error) {
+	var k APIKey
+	err := s.Pool.QueryRow(ctx,
+		`SELECT id, tenant_id, name, scopes, created_at FROM api_keys WHERE key_hash = $1 AND revoked_at IS NULL`,
+		keyHash,
+	).Scan(&k.ID, &k.Tenant, &k.Name, &k.Scopes, &k.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+// CreateAPIKey stores a new key by its hash.
+func (s *Store) CreateAPIKey(ctx context.Context, tenant, name, keyHash string, scopes []string) (APIKey, error) {
+	k := APIKey{Tenant: tenant, Name: name, Scopes: scopes}
+	err := s.Pool.QueryRow(ctx,
+		`INSERT INTO api_keys (tenant_id, name, key_hash, scopes) VALUES ($1, $2, $3, $4) RETURNING id, created_at`,
+		tenant, name, keyHash, scopes,
+	).Scan(&k.ID, &k.CreatedAt)
+	return k, err
+}
+
+// RevokeAPIKey revokes the key with the given id. It reports whether an active
+// key was found.
+func (s *Store) RevokeAPIKey(ctx context.Context, id int64) (bool, error) {
+	tag, err := s.Pool.Exec(ctx, `UPDATE api_keys SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// exportFetchSize — сколько строк забираем из курсора за один FETCH
+const exportFetchSize = 1000
+
+const selectRow = `SELECT id, hash, COALESCE(filename, ''), COALESCE(size, 0), COALESCE(content_type, ''),
+	COALESCE(source, ''), COALESCE(label, ''), created_at FROM hashes`
+
+// ListFilter selects stored hashes for listing and export. Zero fields are
+// not applied except Tenant, which is required; soft-deleted rows are always
+// excluded.
+type ListFilter struct {
+	Tenant string
+	// AfterID returns only rows with id > AfterID, used as a pagination cursor.
+	AfterID int64
+	// ToID returns only rows with id <= ToID.
+	ToID   int64
+	Source string
+	Label  string
+	Since  time.Time
+	Until  time.Time
+}
+
+func (f ListFilter) where() (string, []any) {
+	conds := []string{"tenant_id = $1", "deleted_at IS NULL"}
+	args := []any{f.Tenant}
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if f.AfterID > 0 {
+		add("id > $%d", f.AfterID)
+	}
+	if f.ToID > 0 {
+		add("id <= $%d", f.ToID)
+	}
+	if f.Source != "" {
+		add("source = $%d", f.Source)
+	}
+	if f.Label != "" {
+		add("label = $%d", f.Label)
+	}
+	if !f.Since.IsZero() {
+		add("created_at >= $%d", f.Since)
+	}
+	if !f.Until.IsZero() {
+		add("created_at < $%d", f.Until)
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// List returns up to limit rows matching f ordered by id.
+func (s *Store) List(ctx context.Context, f ListFilter, limit int) ([]HashRow, error) {
+	where, args := f.where()
+	args = append(args, limit)
+	var out []HashRow
+	err := s.withTenant(ctx, f.Tenant, func(q querier) error {
+		rows, err := q.Query(ctx, fmt.Sprintf("%s%s ORDER BY id LIMIT $%d", selectRow, where, len(args)), args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		out, err = scanRows(rows)
+		return err
+	})
+	return out, err
+}
+
+// Export calls fn for every row matching f in id order. Rows are read through
+// a server-side cursor, so memory use does not depend on the result size.
+// Iteration stops at the first error returned by fn.
+func (s *Store) Export(ctx context.Context, f ListFilter, fn func(HashRow) error) error {
+	tx, err := s.begin(ctx, f.Tenant, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	where, args := f.where()
+	if _, err := tx.Exec(ctx, "DECLARE export_cur NO SCROLL CURSOR FOR "+selectRow+where+" ORDER BY id", args...); err != nil {
+		return err
+	}
+
+	for {
+		rows, err := tx.Query(ctx, fmt.Sprintf("FETCH %d FROM export_cur", exportFetchSize))
+		if err != nil {
+			return err
+		}
+		batch, err := scanRows(rows)
+		rows.Close()
+		if err != nil {
+			return err
+		}
+		for _, r := range batch {
+			if err := fn(r); err != nil {
+				return err
+			}
+		}
+		if len(batch) < exportFetchSize {
+			break
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func scanRows(rows pgx.Rows) ([]HashRow, error) {
+	var out []HashRow
+	for rows.Next() {
+		var r HashRow
+		if err := rows.Scan(&r.ID, &r.Hash, &r.Filename, &r.Size, &r.ContentType, &r.Source, &r.Label, &r.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE hashes
+    ADD COLUMN IF NOT EXISTS filename     TEXT,
+    ADD COLUMN IF NOT EXISTS size         BIGINT,
+    ADD COLUMN IF NOT EXISTS content_type TEXT;
+
+-- +goose Down
+ALTER TABLE hashes
+    DROP COLUMN IF EXISTS filename,
+    DROP COLUMN IF EXISTS size,
+    DROP COLUMN IF EXISTS content_type;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE hashes
+    ADD COLUMN IF NOT EXISTS source     TEXT,
+    ADD COLUMN IF NOT EXISTS label      TEXT,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS hashes_source_idx ON hashes (source);
+CREATE INDEX IF NOT EXISTS hashes_created_at_idx ON hashes (created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS hashes_created_at_idx;
+DROP INDEX IF EXISTS hashes_source_idx;
+
+ALTER TABLE hashes
+    DROP COLUMN IF EXISTS source,
+    DROP COLUMN IF EXISTS label,
+    DROP COLUMN IF EXISTS created_at;
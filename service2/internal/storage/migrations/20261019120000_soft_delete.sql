@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE hashes ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE hashes DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS api_keys (
+    id         BIGSERIAL PRIMARY KEY,
+    tenant_id  TEXT        NOT NULL,
+    name       TEXT        NOT NULL DEFAULT '',
+    key_hash   TEXT        NOT NULL UNIQUE,
+    scopes     TEXT[]      NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMPTZ
+);
+
+ALTER TABLE hashes ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+CREATE INDEX IF NOT EXISTS hashes_tenant_id_idx ON hashes (tenant_id, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS hashes_tenant_id_idx;
+ALTER TABLE hashes DROP COLUMN IF EXISTS tenant_id;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS tenant_quotas (
+    tenant_id  TEXT PRIMARY KEY,
+    -- NULL — без ограничения
+    max_hashes BIGINT,
+    -- число неудалённых хешей тенанта, ведётся service2 при вставке и удалении
+    used       BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO tenant_quotas (tenant_id, used)
+SELECT tenant_id, count(*) FROM hashes WHERE deleted_at IS NULL GROUP BY tenant_id
+ON CONFLICT (tenant_id) DO UPDATE SET used = EXCLUDED.used;
+
+-- Политика ограничивает запросы тенантом из app.tenant_id, который service2
+-- выставляет в каждой транзакции при включённом db_rls. Без него (и для
+-- миграций) app.tenant_id пуст и политика ничего не ограничивает.
+ALTER TABLE hashes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE hashes FORCE ROW LEVEL SECURITY;
+CREATE POLICY hashes_tenant_isolation ON hashes
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
+
+-- +goose Down
+DROP POLICY IF EXISTS hashes_tenant_isolation ON hashes;
+ALTER TABLE hashes NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE hashes DISABLE ROW LEVEL SECURITY;
+DROP TABLE IF EXISTS tenant_quotas;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Политика закрыта по умолчанию: запрос к hashes без app.tenant_id падает, а
+-- не видит все тенанты. Миграции выполняются владельцем таблиц
+-- (суперпользователь в docker-compose), service2 подключается отдельной ролью
+-- без SUPERUSER и BYPASSRLS, иначе политика на него не действует.
+DROP POLICY IF EXISTS hashes_tenant_isolation ON hashes;
+CREATE POLICY hashes_tenant_isolation ON hashes
+    USING (tenant_id = current_setting('app.tenant_id'))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id'));
+
+-- +goose Down
+DROP POLICY IF EXISTS hashes_tenant_isolation ON hashes;
+CREATE POLICY hashes_tenant_isolation ON hashes
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
@@ -0,0 +1,16 @@
+-- +goose Up
+-- RLS остаётся необязательным: без app.tenant_id (db_rls выключен, миграции)
+-- политика ничего не ограничивает, как в 20261019140000_tenant_isolation.sql,
+-- поэтому service2 работает под любой ролью, в том числе под суперпользователем.
+-- С db_rls service2 выставляет app.tenant_id в каждой транзакции и при запуске
+-- проверяет, что его роль не обходит политику.
+DROP POLICY IF EXISTS hashes_tenant_isolation ON hashes;
+CREATE POLICY hashes_tenant_isolation ON hashes
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id))
+    WITH CHECK (COALESCE(current_setting('app.tenant_id', true), '') IN ('', tenant_id));
+
+-- +goose Down
+DROP POLICY IF EXISTS hashes_tenant_isolation ON hashes;
+CREATE POLICY hashes_tenant_isolation ON hashes
+    USING (tenant_id = current_setting('app.tenant_id'))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id'));
@@ -3,12 +3,17 @@ package storage
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Store struct {
 	Pool *pgxpool.Pool
+	// RLS включает проверку тенанта ещё и политикой Postgres: каждый запрос к
+	// hashes идёт в транзакции с app.tenant_id
+	RLS bool
 }
 
 func New(ctx context.Context, dsn string) (*Store, error) {
@@ -30,6 +35,19 @@ func New(ctx context.Context, dsn string) (*Store, error) {
 type HashRow struct {
 	ID   int64
 	Hash string
+
+	// метаданные загруженного файла, пустые для строк из /send
+	Filename    string
+	Size        int64
+	ContentType string
+
+	// откуда пришла строка при импорте, необязательные
+	Source string
+	Label  string
+
+	CreatedAt time.Time
+	// Deleted — строка удалена мягко и отдаётся только как статус
+	Deleted bool
 }
 
 func (s *Store) Close() {
@@ -38,17 +56,27 @@ func (s *Store) Close() {
 	}
 }
 
-func (s *Store) InsertHashes(ctx context.Context, hashes []string) ([]HashRow, error) {
-	tx, err := s.Pool.Begin(ctx)
+// DefaultTenant owns rows written before multi-tenancy and all rows when
+// authentication is disabled.
+const DefaultTenant = "default"
+
+// InsertHashes saves hashes for tenant. It fails with ErrQuotaExceeded
+// without saving anything if they do not fit into the tenant's quota.
+func (s *Store) InsertHashes(ctx context.Context, tenant string, hashes []string) ([]HashRow, error) {
+	tx, err := s.begin(ctx, tenant, pgx.TxOptions{})
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback(ctx)
 
+	if err := reserve(ctx, tx, tenant, len(hashes)); err != nil {
+		return nil, err
+	}
+
 	rows := make([]HashRow, 0, len(hashes))
 	for _, h := range hashes {
 		var id int64
-		if err := tx.QueryRow(ctx, `INSERT INTO hashes (hash) VALUES ($1) RETURNING id`, h).Scan(&id); err != nil {
+		if err := tx.QueryRow(ctx, `INSERT INTO hashes (tenant_id, hash) VALUES ($1, $2) RETURNING id`, tenant, h).Scan(&id); err != nil {
 			return nil, err
 		}
 		rows = append(rows, HashRow{ID: id, Hash: h})
@@ -60,24 +88,112 @@ func (s *Store) InsertHashes(ctx context.Context, hashes []string) ([]HashRow, e
 	return rows, nil
 }
 
-func (s *Store) GetByIDs(ctx context.Context, ids []int64) ([]HashRow, error) {
+// InsertRows saves hashes together with their metadata and fills in the
+// generated IDs. Empty metadata fields are stored as NULL. Quotas are checked
+// as in InsertHashes.
+func (s *Store) InsertRows(ctx context.Context, tenant string, in []HashRow) ([]HashRow, error) {
+	tx, err := s.begin(ctx, tenant, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := reserve(ctx, tx, tenant, len(in)); err != nil {
+		return nil, err
+	}
+
+	rows := make([]HashRow, 0, len(in))
+	for _, r := range in {
+		var size any
+		if r.Filename != "" {
+			size = r.Size
+		}
+		if err := tx.QueryRow(ctx,
+			`INSERT INTO hashes (tenant_id, hash, filename, size, content_type, source, label)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at`,
+			tenant, r.Hash, nullable(r.Filename), size, nullable(r.ContentType), nullable(r.Source), nullable(r.Label),
+		).Scan(&r.ID, &r.CreatedAt); err != nil {
+			return nil, err
+		}
+		rows = append(rows, r)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func nullable(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// GetByIDs returns the rows of tenant with the given ids, including
+// soft-deleted ones with Deleted set. IDs that were never stored or belong to
+// another tenant are absent from the result.
+func (s *Store) GetByIDs(ctx context.Context, tenant string, ids []int64) ([]HashRow, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("empty ids")
+	}
+	var out []HashRow
+	err := s.withTenant(ctx, tenant, func(q querier) error {
+		// ANY($1) работает и с массивом в pgx
+		rows, err := q.Query(ctx, `SELECT id, hash, deleted_at IS NOT NULL FROM hashes WHERE tenant_id = $1 AND id = ANY($2) ORDER BY id`, tenant, ids)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var r HashRow
+			if err := rows.Scan(&r.ID, &r.Hash, &r.Deleted); err != nil {
+				return err
+			}
+			out = append(out, r)
+		}
+		return rows.Err()
+	})
+	return out, err
+}
+
+// Delete soft-deletes the rows of tenant with the given ids and returns the
+// ids that were actually deleted by this call.
+func (s *Store) Delete(ctx context.Context, tenant string, ids []int64) ([]int64, error) {
 	if len(ids) == 0 {
 		return nil, errors.New("empty ids")
 	}
-	// ANY($1) работает и с массивом в pgx
-	rows, err := s.Pool.Query(ctx, `SELECT id, hash FROM hashes WHERE id = ANY($1) ORDER BY id`, ids)
+	tx, err := s.begin(ctx, tenant, pgx.TxOptions{})
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	defer tx.Rollback(ctx)
 
-	var out []HashRow
+	rows, err := tx.Query(ctx,
+		`UPDATE hashes SET deleted_at = now() WHERE tenant_id = $1 AND id = ANY($2) AND deleted_at IS NULL RETURNING id`, tenant, ids)
+	if err != nil {
+		return nil, err
+	}
+	var out []int64
 	for rows.Next() {
-		var r HashRow
-		if err := rows.Scan(&r.ID, &r.Hash); err != nil {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(out) > 0 {
+		if _, err := tx.Exec(ctx, `UPDATE tenant_quotas SET used = used - $2 WHERE tenant_id = $1`, tenant, len(out)); err != nil {
 			return nil, err
 		}
-		out = append(out, r)
 	}
-	return out, rows.Err()
+	return out, tx.Commit(ctx)
 }
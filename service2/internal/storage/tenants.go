@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrQuotaExceeded is returned when an insert would take a tenant over its
+// max_hashes quota. Nothing is inserted in that case.
+var ErrQuotaExceeded = errors.New("tenant quota exceeded")
+
+// Quota is the storage quota of a tenant.
+type Quota struct {
+	Tenant string
+	// MaxHashes limits the number of stored (not deleted) hashes, 0 — no limit.
+	MaxHashes int64
+	Used      int64
+}
+
+// querier is implemented by both the pool and a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// begin starts a transaction scoped to tenant. With RLS enabled it sets
+// app.tenant_id for the hashes_tenant_isolation policy.
+func (s *Store) begin(ctx context.Context, tenant string, opts pgx.TxOptions) (pgx.Tx, error) {
+	tx, err := s.Pool.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if s.RLS {
+		if _, err := tx.Exec(ctx, `SELECT set_config('app.tenant_id', $1, true)`, tenant); err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// BypassesRLS reports whether the role the store connects as is exempt from
+// row-level security, being a superuser or having BYPASSRLS.
+func (s *Store) BypassesRLS(ctx context.Context) (bool, error) {
+	var bypass bool
+	err := s.Pool.QueryRow(ctx,
+		`SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`,
+	).Scan(&bypass)
+	return bypass, err
+}
+
+// withTenant runs a read-only fn for tenant. Without RLS it runs on the pool
+// directly to avoid the extra round trips of a transaction.
+func (s *Store) withTenant(ctx context.Context, tenant string, fn func(q querier) error) error {
+	if !s.RLS {
+		return fn(s.Pool)
+	}
+	tx, err := s.begin(ctx, tenant, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// reserve adds n to the usage of tenant within tx and fails with
+// ErrQuotaExceeded if that takes it over the quota. The quota row stays
+// locked until tx ends, so concurrent inserts of a tenant cannot overshoot.
+func reserve(ctx context.Context, tx pgx.Tx, tenant string, n int) error {
+	var used, limit int64
+	err := tx.QueryRow(ctx,
+		`INSERT INTO tenant_quotas (tenant_id, used) VALUES ($1, $2)
+		 ON CONFLICT (tenant_id) DO UPDATE SET used = tenant_quotas.used + EXCLUDED.used
+		 RETURNING used, COALESCE(max_hashes, 0)`,
+		tenant, n,
+	).Scan(&used, &limit)
+	if err != nil {
+		return err
+	}
+	if limit > 0 && used > limit {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// GetQuota returns the quota and usage of tenant. A tenant that has never
+// stored anything has no limit and zero usage.
+func (s *Store) GetQuota(ctx context.Context, tenant string) (Quota, error) {
+	q := Quota{Tenant: tenant}
+	err := s.Pool.QueryRow(ctx,
+		`SELECT COALESCE(max_hashes, 0), used FROM tenant_quotas WHERE tenant_id = $1`, tenant,
+	).Scan(&q.MaxHashes, &q.Used)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return q, nil
+	}
+	return q, err
+}
+
+// SetQuota sets the max_hashes quota of tenant, 0 removes the limit. Hashes
+// already stored above a lowered quota are kept, only new inserts fail.
+func (s *Store) SetQuota(ctx context.Context, tenant string, maxHashes int64) (Quota, error) {
+	q := Quota{Tenant: tenant, MaxHashes: maxHashes}
+	err := s.Pool.QueryRow(ctx,
+		`INSERT INTO tenant_quotas (tenant_id, max_hashes) VALUES ($1, $2)
+		 ON CONFLICT (tenant_id) DO UPDATE SET max_hashes = EXCLUDED.max_hashes
+		 RETURNING used`,
+		tenant, nullableInt(maxHashes),
+	).Scan(&q.Used)
+	return q, err
+}
+
+func nullableInt(v int64) any {
+	if v == 0 {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,170 @@
+// Package tlsconf builds the TLS config of the gRPC client to service1 from
+// PEM files and reloads it when the files change, so that rotated
+// certificates are picked up without a restart.
+package tlsconf
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Files are the PEM files of the client. Cert and Key, if set, are presented
+// to the server for mutual TLS. CA, if set, replaces the system roots for
+// verifying the server.
+type Files struct {
+	Cert string
+	Key  string
+	CA   string
+}
+
+// Reloader holds the current client certificate and CA pool.
+type Reloader struct {
+	files Files
+	log   *logrus.Logger
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	version string
+}
+
+// NewReloader loads files once and fails if any of them is invalid.
+func NewReloader(files Files, log *logrus.Logger) (*Reloader, error) {
+	r := &Reloader{files: files, log: log}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Watch checks the files every interval and reloads them when their size or
+// modification time changes. A failed reload is logged and the previous
+// files stay in use. Watch returns when ctx is done.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		v, err := r.stat()
+		r.mu.RLock()
+		changed := err == nil && v != r.version
+		r.mu.RUnlock()
+		if !changed {
+			continue
+		}
+		if err := r.reload(); err != nil {
+			r.log.WithError(err).Error("tls: reload failed, keeping previous certificates")
+			continue
+		}
+		r.log.WithField("cert", r.files.Cert).WithField("ca", r.files.CA).Info("tls: certificates reloaded")
+	}
+}
+
+// ClientConfig returns a config that presents the current client certificate
+// and verifies the server against the current CA. serverName, if set,
+// overrides the name the server certificate is checked for.
+func (r *Reloader) ClientConfig(serverName string) *tls.Config {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+	if r.files.Cert != "" {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return r.cert, nil
+		}
+	}
+	if r.files.CA != "" {
+		// gRPC копирует конфиг один раз, и RootCAs в копии уже не подменить, а
+		// у клиента нет аналога GetConfigForClient. Поэтому стандартная проверка
+		// отключается, и цепочка с именем проверяются в VerifyConnection по
+		// текущему пулу при каждом рукопожатии, включая возобновлённые сессии
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = r.verifyServer
+	}
+	return cfg
+}
+
+func (r *Reloader) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server sent no certificate")
+	}
+	// с пустым DNSName Verify не проверяет имя вовсе
+	if cs.ServerName == "" {
+		return errors.New("tls: no server name to verify")
+	}
+	r.mu.RLock()
+	roots := r.pool
+	r.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, c := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       cs.ServerName,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
+
+func (r *Reloader) reload() error {
+	// версию снимаем до чтения: если файл поменяется во время чтения,
+	// следующая проверка перечитает его ещё раз
+	v, err := r.stat()
+	if err != nil {
+		return err
+	}
+	var cert *tls.Certificate
+	if r.files.Cert != "" {
+		c, err := tls.LoadX509KeyPair(r.files.Cert, r.files.Key)
+		if err != nil {
+			return fmt.Errorf("load key pair: %w", err)
+		}
+		cert = &c
+	}
+	var pool *x509.CertPool
+	if r.files.CA != "" {
+		pem, err := os.ReadFile(r.files.CA)
+		if err != nil {
+			return fmt.Errorf("read ca: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in %s", r.files.CA)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.pool, r.version = cert, pool, v
+	r.mu.Unlock()
+	return nil
+}
+
+// stat returns a string that changes whenever any of the files changes.
+func (r *Reloader) stat() (string, error) {
+	var v string
+	for _, name := range []string{r.files.Cert, r.files.Key, r.files.CA} {
+		if name == "" {
+			continue
+		}
+		fi, err := os.Stat(name)
+		if err != nil {
+			return "", err
+		}
+		v += fmt.Sprintf("%s:%d:%d;", name, fi.Size(), fi.ModTime().UnixNano())
+	}
+	return v, nil
+}
@@ -0,0 +1,207 @@
+package tlsconf_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"service2/internal/tlsconf"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key signed by ca for dns, valid until
+// notAfter.
+func (ca *testCA) issue(t *testing.T, serial int64, dns string, usage x509.ExtKeyUsage, notAfter time.Time) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: dns},
+		NotBefore:    time.Now().Add(-2 * time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{dns},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+// startServer accepts TLS connections with a certificate for "service1"
+// issued by ca and, if clientCA is set, requires a client certificate signed
+// by it. The serial of the client certificate of each handshake is sent to
+// the returned channel.
+func startServer(t *testing.T, ca *testCA, serial int64, usage x509.ExtKeyUsage, notAfter time.Time, clientCA *testCA) (string, <-chan int64) {
+	t.Helper()
+	cert, err := tls.X509KeyPair(ca.issue(t, serial, "service1", usage, notAfter))
+	require.NoError(t, err)
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if clientCA != nil {
+		pool := x509.NewCertPool()
+		pool.AddCert(clientCA.cert)
+		cfg.ClientCAs, cfg.ClientAuth = pool, tls.RequireAndVerifyClientCert
+	}
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+
+	serials := make(chan int64, 10)
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				tc := conn.(*tls.Conn)
+				if tc.Handshake() != nil {
+					return
+				}
+				if peers := tc.ConnectionState().PeerCertificates; len(peers) > 0 {
+					serials <- peers[0].SerialNumber.Int64()
+				}
+			}()
+		}
+	}()
+	return lis.Addr().String(), serials
+}
+
+func dial(addr string, cfg *tls.Config) error {
+	raw, err := net.DialTimeout("tcp", addr, 2*time.Second)
+	if err != nil {
+		return err
+	}
+	conn := tls.Client(raw, cfg)
+	defer conn.Close()
+	return conn.Handshake()
+}
+
+func TestClientConfig_VerifiesServer(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCA(t)
+	files := tlsconf.Files{CA: filepath.Join(dir, "ca.pem")}
+	writeFile(t, files.CA, ca.pem)
+	certs, err := tlsconf.NewReloader(files, logrus.New())
+	require.NoError(t, err)
+	valid := time.Now().Add(time.Hour)
+
+	addr, _ := startServer(t, ca, 2, x509.ExtKeyUsageServerAuth, valid, nil)
+	require.NoError(t, dial(addr, certs.ClientConfig("service1")))
+
+	// имя не совпадает с SAN
+	require.Error(t, dial(addr, certs.ClientConfig("other")))
+	// без имени проверять нечего
+	require.Error(t, dial(addr, certs.ClientConfig("")))
+
+	// сертификат от чужого CA
+	addr, _ = startServer(t, newCA(t), 3, x509.ExtKeyUsageServerAuth, valid, nil)
+	require.Error(t, dial(addr, certs.ClientConfig("service1")))
+
+	// просроченный сертификат
+	addr, _ = startServer(t, ca, 4, x509.ExtKeyUsageServerAuth, time.Now().Add(-time.Hour), nil)
+	require.Error(t, dial(addr, certs.ClientConfig("service1")))
+
+	// сертификат клиента вместо серверного
+	addr, _ = startServer(t, ca, 5, x509.ExtKeyUsageClientAuth, valid, nil)
+	require.Error(t, dial(addr, certs.ClientConfig("service1")))
+}
+
+func TestReloader_PicksUpRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	oldCA, rotatedCA := newCA(t), newCA(t)
+	files := tlsconf.Files{
+		Cert: filepath.Join(dir, "client.pem"),
+		Key:  filepath.Join(dir, "client-key.pem"),
+		CA:   filepath.Join(dir, "ca.pem"),
+	}
+	valid := time.Now().Add(time.Hour)
+	cert, key := oldCA.issue(t, 2, "service2", x509.ExtKeyUsageClientAuth, valid)
+	writeFile(t, files.Cert, cert)
+	writeFile(t, files.Key, key)
+	writeFile(t, files.CA, oldCA.pem)
+
+	certs, err := tlsconf.NewReloader(files, logrus.New())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go certs.Watch(ctx, 10*time.Millisecond)
+
+	// конфиг берётся один раз, как его берёт gRPC
+	cfg := certs.ClientConfig("service1")
+	oldAddr, oldSerials := startServer(t, oldCA, 3, x509.ExtKeyUsageServerAuth, valid, oldCA)
+	newAddr, newSerials := startServer(t, rotatedCA, 4, x509.ExtKeyUsageServerAuth, valid, rotatedCA)
+
+	require.NoError(t, dial(oldAddr, cfg))
+	require.EqualValues(t, 2, <-oldSerials)
+	require.Error(t, dial(newAddr, cfg))
+
+	cert, key = rotatedCA.issue(t, 5, "service2", x509.ExtKeyUsageClientAuth, valid)
+	writeFile(t, files.Key, key)
+	writeFile(t, files.Cert, cert)
+	writeFile(t, files.CA, rotatedCA.pem)
+
+	require.Eventually(t, func() bool {
+		return dial(newAddr, cfg) == nil
+	}, 2*time.Second, 20*time.Millisecond)
+	require.EqualValues(t, 5, <-newSerials)
+	require.Error(t, dial(oldAddr, cfg))
+}
+
+func TestNewReloader_InvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := tlsconf.Files{CA: filepath.Join(dir, "ca.pem")}
+	writeFile(t, files.CA, []byte("not a cert"))
+
+	_, err := tlsconf.NewReloader(files, logrus.New())
+	require.Error(t, err)
+}